@@ -0,0 +1,364 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// command is an action on a resource.
+type command struct {
+	// The arguments of the command, after "smctl <resource> <action>".
+	usage string
+
+	// The fields that are shown by the table output format.
+	columns []string
+
+	// run parses the arguments of the command and returns its result.
+	run func(ctx context.Context, c *cli, usage string, args []string) (result interface{}, err error)
+}
+
+var (
+	secretColumns        = []string{"id", "name", "secret_type", "secret_group_id", "state_description", "versions_total", "created_at"}
+	secretVersionColumns = []string{"id", "alias", "secret_type", "payload_available", "created_at"}
+	secretGroupColumns   = []string{"id", "name", "description", "created_at"}
+	secretLockColumns    = []string{"name", "secret_version_alias", "secret_version_id", "description", "created_at"}
+	secretLocksColumns   = []string{"secret_id", "secret_name", "secret_type", "secret_group_id", "versions"}
+	configurationColumns = []string{"name", "config_type", "secret_type", "created_at"}
+)
+
+// commands maps the resources to their actions.
+var commands = map[string]map[string]command{
+	"secrets": {
+		"list":   {"[-search TEXT] [-groups IDS] [-secret-types TYPES] [-labels LABELS] [-sort FIELD]", secretColumns, listSecrets},
+		"get":    {"<secret_id> | -type TYPE -name NAME [-group GROUP]", secretColumns, getSecret},
+		"create": {"-file FILE", secretColumns, createSecret},
+	},
+	"versions": {
+		"list":   {"<secret_id>", secretVersionColumns, listSecretVersions},
+		"get":    {"<secret_id> <version_id>", secretVersionColumns, getSecretVersion},
+		"create": {"<secret_id> -file FILE", secretVersionColumns, createSecretVersion},
+	},
+	"groups": {
+		"list":   {"", secretGroupColumns, listSecretGroups},
+		"get":    {"<secret_group_id>", secretGroupColumns, getSecretGroup},
+		"create": {"<name> [-description TEXT]", secretGroupColumns, createSecretGroup},
+	},
+	"locks": {
+		"list":   {"[<secret_id> [<version_id>]] [-search TEXT]", secretLockColumns, listLocks},
+		"create": {"<secret_id> [<version_id>] -name NAME [-description TEXT] [-mode MODE]", secretLocksColumns, createLock},
+	},
+	"configurations": {
+		"list":   {"[-search TEXT] [-secret-types TYPES] [-sort FIELD]", configurationColumns, listConfigurations},
+		"get":    {"<name>", configurationColumns, getConfiguration},
+		"create": {"-file FILE", configurationColumns, createConfiguration},
+	},
+}
+
+func listSecrets(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	search := flags.String("search", "", "only list the secrets that match the text")
+	groups := flags.String("groups", "", "only list the secrets in the comma-separated secret group IDs")
+	secretTypes := flags.String("secret-types", "", "only list the secrets of the comma-separated types")
+	labels := flags.String("labels", "", "only list the secrets that have all the comma-separated labels")
+	sort := flags.String("sort", "", "the field to sort by, prefixed with '-' for descending order")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return nil, err
+	}
+
+	listSecretsOptions := c.client.NewListSecretsOptions()
+	if *search != "" {
+		listSecretsOptions.SetSearch(*search)
+	}
+	if *sort != "" {
+		listSecretsOptions.SetSort(*sort)
+	}
+	listSecretsOptions.Groups = splitList(*groups)
+	listSecretsOptions.SecretTypes = splitList(*secretTypes)
+	listSecretsOptions.MatchAllLabels = splitList(*labels)
+	pager, err := c.client.NewSecretsPager(listSecretsOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func getSecret(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	secretType := flags.String("type", "", "the type of the secret, to get it by name")
+	name := flags.String("name", "", "the name of the secret, to get it by name")
+	group := flags.String("group", "default", "the name of the secret group, to get the secret by name")
+	positional, err := parseFlags(flags, args, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret secretsmanagerv2.SecretIntf
+	switch {
+	case len(positional) == 1 && *secretType == "" && *name == "":
+		secret, _, err = c.client.GetSecretWithContext(ctx, c.client.NewGetSecretOptions(positional[0]))
+	case len(positional) == 0 && *secretType != "" && *name != "":
+		secret, _, err = c.client.GetSecretByNameTypeWithContext(ctx, c.client.NewGetSecretByNameTypeOptions(*secretType, *name, *group))
+	default:
+		flags.Usage()
+		return nil, errUsage
+	}
+	return secret, err
+}
+
+func createSecret(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	file := flags.String("file", "", "the JSON file of the secret prototype, or '-' for the standard input")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return nil, err
+	}
+
+	var prototype secretsmanagerv2.SecretPrototypeIntf
+	if err := c.readModel(flags, *file, secretsmanagerv2.UnmarshalSecretPrototype, &prototype); err != nil {
+		return nil, err
+	}
+	secret, _, err := c.client.CreateSecretWithContext(ctx, c.client.NewCreateSecretOptions(prototype))
+	return secret, err
+}
+
+func listSecretVersions(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	positional, err := parseFlags(c.newFlagSet(usage), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	pager, err := c.client.NewSecretVersionsPager(c.client.NewListSecretVersionsOptions(positional[0]))
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func getSecretVersion(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	positional, err := parseFlags(c.newFlagSet(usage), args, 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	version, _, err := c.client.GetSecretVersionWithContext(ctx, c.client.NewGetSecretVersionOptions(positional[0], positional[1]))
+	return version, err
+}
+
+func createSecretVersion(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	file := flags.String("file", "", "the JSON file of the secret version prototype, or '-' for the standard input")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var prototype secretsmanagerv2.SecretVersionPrototypeIntf
+	if err = c.readModel(flags, *file, secretsmanagerv2.UnmarshalSecretVersionPrototype, &prototype); err != nil {
+		return nil, err
+	}
+	version, _, err := c.client.CreateSecretVersionWithContext(ctx, c.client.NewCreateSecretVersionOptions(positional[0], prototype))
+	return version, err
+}
+
+func listSecretGroups(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	if _, err := parseFlags(c.newFlagSet(usage), args, 0, 0); err != nil {
+		return nil, err
+	}
+	pager, err := c.client.NewSecretGroupsPager(c.client.NewListSecretGroupsOptions())
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func getSecretGroup(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	positional, err := parseFlags(c.newFlagSet(usage), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	group, _, err := c.client.GetSecretGroupWithContext(ctx, c.client.NewGetSecretGroupOptions(positional[0]))
+	return group, err
+}
+
+func createSecretGroup(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	description := flags.String("description", "", "the description of the secret group")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	createSecretGroupOptions := c.client.NewCreateSecretGroupOptions(positional[0])
+	if *description != "" {
+		createSecretGroupOptions.SetDescription(*description)
+	}
+	group, _, err := c.client.CreateSecretGroupWithContext(ctx, createSecretGroupOptions)
+	return group, err
+}
+
+func listLocks(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	search := flags.String("search", "", "only list the locks that match the text")
+	positional, err := parseFlags(flags, args, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(positional) {
+	case 0:
+		listSecretsLocksOptions := c.client.NewListSecretsLocksOptions()
+		if *search != "" {
+			listSecretsLocksOptions.SetSearch(*search)
+		}
+		pager, err := c.client.NewSecretsLocksPager(listSecretsLocksOptions)
+		if err != nil {
+			return nil, err
+		}
+		// The locks of all secrets are grouped by secret, so they are shown with the secret columns.
+		locks, err := pager.GetAllWithContext(ctx)
+		return withColumns{locks, secretLocksColumns}, err
+	case 1:
+		listSecretLocksOptions := c.client.NewListSecretLocksOptions(positional[0])
+		if *search != "" {
+			listSecretLocksOptions.SetSearch(*search)
+		}
+		pager, err := c.client.NewSecretLocksPager(listSecretLocksOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	default:
+		listSecretVersionLocksOptions := c.client.NewListSecretVersionLocksOptions(positional[0], positional[1])
+		if *search != "" {
+			listSecretVersionLocksOptions.SetSearch(*search)
+		}
+		pager, err := c.client.NewSecretVersionLocksPager(listSecretVersionLocksOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	}
+}
+
+func createLock(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	name := flags.String("name", "", "the name of the lock")
+	description := flags.String("description", "", "the description of the lock")
+	mode := flags.String("mode", "", "the lock mode: remove_previous or remove_previous_and_delete")
+	positional, err := parseFlags(flags, args, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := c.client.NewSecretLockPrototype(*name)
+	if err != nil {
+		return nil, err
+	}
+	if *description != "" {
+		lock.Description = core.StringPtr(*description)
+	}
+	locks := []secretsmanagerv2.SecretLockPrototype{*lock}
+
+	var result *secretsmanagerv2.SecretLocks
+	if len(positional) == 1 {
+		createSecretLocksBulkOptions := c.client.NewCreateSecretLocksBulkOptions(positional[0], locks)
+		if *mode != "" {
+			createSecretLocksBulkOptions.SetMode(*mode)
+		}
+		result, _, err = c.client.CreateSecretLocksBulkWithContext(ctx, createSecretLocksBulkOptions)
+	} else {
+		createSecretVersionLocksBulkOptions := c.client.NewCreateSecretVersionLocksBulkOptions(positional[0], positional[1], locks)
+		if *mode != "" {
+			createSecretVersionLocksBulkOptions.SetMode(*mode)
+		}
+		result, _, err = c.client.CreateSecretVersionLocksBulkWithContext(ctx, createSecretVersionLocksBulkOptions)
+	}
+	return result, err
+}
+
+func listConfigurations(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	search := flags.String("search", "", "only list the configurations that match the text")
+	secretTypes := flags.String("secret-types", "", "only list the configurations of the comma-separated secret types")
+	sort := flags.String("sort", "", "the field to sort by, prefixed with '-' for descending order")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return nil, err
+	}
+
+	listConfigurationsOptions := c.client.NewListConfigurationsOptions()
+	if *search != "" {
+		listConfigurationsOptions.SetSearch(*search)
+	}
+	if *sort != "" {
+		listConfigurationsOptions.SetSort(*sort)
+	}
+	listConfigurationsOptions.SecretTypes = splitList(*secretTypes)
+	pager, err := c.client.NewConfigurationsPager(listConfigurationsOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func getConfiguration(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	positional, err := parseFlags(c.newFlagSet(usage), args, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	configuration, _, err := c.client.GetConfigurationWithContext(ctx, c.client.NewGetConfigurationOptions(positional[0]))
+	return configuration, err
+}
+
+func createConfiguration(ctx context.Context, c *cli, usage string, args []string) (interface{}, error) {
+	flags := c.newFlagSet(usage)
+	file := flags.String("file", "", "the JSON file of the configuration prototype, or '-' for the standard input")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return nil, err
+	}
+
+	var prototype secretsmanagerv2.ConfigurationPrototypeIntf
+	if err := c.readModel(flags, *file, secretsmanagerv2.UnmarshalConfigurationPrototype, &prototype); err != nil {
+		return nil, err
+	}
+	configuration, _, err := c.client.CreateConfigurationWithContext(ctx, c.client.NewCreateConfigurationOptions(prototype))
+	return configuration, err
+}
+
+// readModel reads a JSON model from the file of the "-file" flag and
+// unmarshals it with the generated unmarshaler of the model.
+func (c *cli) readModel(flags *flag.FlagSet, file string, unmarshal func(map[string]json.RawMessage, interface{}) error, result interface{}) error {
+	if file == "" {
+		flags.Usage()
+		return errUsage
+	}
+	content, err := c.readInput(file)
+	if err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(content, &raw); err != nil {
+		return fmt.Errorf("reading %s: %w", file, err)
+	}
+	if err = unmarshal(raw, result); err != nil {
+		return fmt.Errorf("reading %s: %w", file, err)
+	}
+	return nil
+}
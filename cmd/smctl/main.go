@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command smctl lists, gets and creates the secrets, secret versions, secret
+// groups, secret locks and configurations of a Secrets Manager instance.
+//
+// Usage:
+//
+//	smctl [-output json|yaml|table] [-url URL] [-service-name NAME] <resource> <action> [arguments]
+//
+// The client is configured like NewSecretsManagerV2UsingExternalConfig: the
+// authenticator and the URL of the instance are read from the environment
+// variables, credentials file or VCAP_SERVICES entry of the service name,
+// which defaults to "secrets_manager". For example:
+//
+//	export SECRETS_MANAGER_URL=https://<instance_id>.<region>.secrets-manager.appdomain.cloud
+//	export SECRETS_MANAGER_AUTH_TYPE=iam
+//	export SECRETS_MANAGER_APIKEY=<api_key>
+//	smctl secrets list -secret-types arbitrary,kv
+//	smctl -output json secrets get 0b5571f7-21e6-42b7-91c5-3f5ac9793a46
+//	smctl secrets create -file prototype.json
+//
+// Run "smctl help" for the list of resources and actions.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// Exit codes.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage is returned by commands that are invoked with invalid arguments.
+var errUsage = errors.New("invalid arguments")
+
+// cli holds the state that is shared by the commands.
+type cli struct {
+	client *secretsmanagerv2.SecretsManagerV2
+	stdin  io.Reader
+	stderr io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs smctl with the command-line arguments "args" and returns the exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("smctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	output := flags.String("output", formatTable, "the output format: json, yaml or table")
+	url := flags.String("url", "", "the URL of the Secrets Manager instance, which overrides the external configuration")
+	serviceName := flags.String("service-name", secretsmanagerv2.DefaultServiceName, "the service name of the external configuration")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: smctl [flags] <resource> <action> [arguments]\n\nFlags:\n")
+		flags.PrintDefaults()
+		printCommands(stderr)
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if flags.NArg() == 0 || flags.Arg(0) == "help" {
+		flags.Usage()
+		return exitOK
+	}
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return exitUsage
+	}
+	cmd, ok := commands[flags.Arg(0)][flags.Arg(1)]
+	if !ok {
+		fmt.Fprintf(stderr, "smctl: unknown command '%s %s'\n", flags.Arg(0), flags.Arg(1))
+		printCommands(stderr)
+		return exitUsage
+	}
+	formatter, ok := formatters[*output]
+	if !ok {
+		fmt.Fprintf(stderr, "smctl: unknown output format '%s'\n", *output)
+		return exitUsage
+	}
+
+	client, err := secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(&secretsmanagerv2.SecretsManagerV2Options{
+		ServiceName: *serviceName,
+		URL:         *url,
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "smctl: %s\n", err)
+		return exitError
+	}
+
+	c := &cli{client: client, stdin: stdin, stderr: stderr}
+	usage := fmt.Sprintf("smctl %s %s %s", flags.Arg(0), flags.Arg(1), cmd.usage)
+	result, err := cmd.run(ctx, c, usage, flags.Args()[2:])
+	if errors.Is(err, errUsage) {
+		return exitUsage
+	}
+	if err == nil {
+		columns := cmd.columns
+		if override, ok := result.(withColumns); ok {
+			result, columns = override.result, override.columns
+		}
+		err = formatter(stdout, result, columns)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "smctl: %s\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+func printCommands(w io.Writer) {
+	fmt.Fprintf(w, "\nCommands:\n")
+	var resources []string
+	for resource := range commands {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		var actions []string
+		for action := range commands[resource] {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+		for _, action := range actions {
+			fmt.Fprintf(w, "  %s\n", strings.TrimSpace(fmt.Sprintf("%s %s %s", resource, action, commands[resource][action].usage)))
+		}
+	}
+}
+
+// parseFlags parses the flags of a command, which may be interleaved with
+// its positional arguments, and checks the number of positional arguments.
+func parseFlags(flags *flag.FlagSet, args []string, minArgs int, maxArgs int) (positional []string, err error) {
+	for {
+		if err = flags.Parse(args); err != nil {
+			return nil, errUsage
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if len(positional) < minArgs || len(positional) > maxArgs {
+		flags.Usage()
+		return nil, errUsage
+	}
+	return
+}
+
+// newFlagSet returns the flag set of a command.
+func (c *cli) newFlagSet(usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(usage, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: %s\n", usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// readInput returns the content of the file "path", or of the standard input if "path" is "-".
+func (c *cli) readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(c.stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// splitList splits a comma-separated flag value.
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runCLI runs smctl against a fake server that is configured by environment variables.
+func runCLI(t *testing.T, stdin string, args ...string) (exitCode int, stdout string, stderr string) {
+	var stdoutBuffer, stderrBuffer bytes.Buffer
+	exitCode = run(context.Background(), args, strings.NewReader(stdin), &stdoutBuffer, &stderrBuffer)
+	return exitCode, stdoutBuffer.String(), stderrBuffer.String()
+}
+
+func setUpServer(t *testing.T) {
+	server := smtest.NewServer()
+	t.Cleanup(server.Close)
+	t.Setenv("SECRETS_MANAGER_URL", server.URL)
+	t.Setenv("SECRETS_MANAGER_AUTH_TYPE", "noauth")
+}
+
+func TestSecrets(t *testing.T) {
+	setUpServer(t)
+
+	exitCode, stdout, stderr := runCLI(t, `{"secret_type": "arbitrary", "name": "my-secret", "payload": "my-payload"}`,
+		"-output", "json", "secrets", "create", "-file", "-")
+	require.Equal(t, 0, exitCode, stderr)
+	var secret map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(stdout), &secret))
+	assert.Equal(t, "my-payload", secret["payload"])
+	id := secret["id"].(string)
+
+	exitCode, stdout, stderr = runCLI(t, "", "secrets", "list", "-secret-types", "arbitrary")
+	require.Equal(t, 0, exitCode, stderr)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, 2)
+	assert.Regexp(t, `^ID\s+NAME\s+SECRET_TYPE\s+`, lines[0])
+	assert.Regexp(t, `^`+id+`\s+my-secret\s+arbitrary\s+default\s+`, lines[1])
+
+	exitCode, stdout, stderr = runCLI(t, "", "-output", "yaml", "secrets", "get", "-type", "arbitrary", "-name", "my-secret")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Contains(t, stdout, "payload: my-payload\n")
+	assert.Contains(t, stdout, "id: "+id+"\n")
+
+	versionFile := filepath.Join(t.TempDir(), "version.json")
+	require.Nil(t, os.WriteFile(versionFile, []byte(`{"payload": "new-payload"}`), 0600))
+	exitCode, _, stderr = runCLI(t, "", "versions", "create", id, "-file", versionFile)
+	require.Equal(t, 0, exitCode, stderr)
+
+	exitCode, stdout, stderr = runCLI(t, "", "-output", "json", "versions", "get", id, "current")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Contains(t, stdout, `"payload": "new-payload"`)
+
+	exitCode, stdout, stderr = runCLI(t, "", "versions", "list", id)
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Len(t, strings.Split(strings.TrimSpace(stdout), "\n"), 3)
+
+	exitCode, _, stderr = runCLI(t, "", "secrets", "get", "b49ad24d-81d4-5ebc-b9b9-b0937d1c84d5")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "smctl: ")
+}
+
+func TestEmptyTable(t *testing.T) {
+	setUpServer(t)
+
+	exitCode, stdout, stderr := runCLI(t, "", "secrets", "list")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Regexp(t, `^ID\s+NAME\s+SECRET_TYPE\s+[A-Z_ ]+\n$`, stdout)
+
+	exitCode, stdout, stderr = runCLI(t, "", "locks", "list")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Equal(t, 1, strings.Count(stdout, "\n"))
+
+	var table bytes.Buffer
+	require.Nil(t, writeTable(&table, []secretsmanagerv2.SecretGroup(nil), []string{"id", "name"}))
+	assert.Equal(t, "ID  NAME\n", table.String())
+}
+
+func TestGroupsAndLocks(t *testing.T) {
+	setUpServer(t)
+
+	exitCode, stdout, stderr := runCLI(t, "", "groups", "create", "my-group", "-description", "My group")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Contains(t, stdout, "My group")
+
+	exitCode, stdout, stderr = runCLI(t, "", "-output", "json", "groups", "list")
+	require.Equal(t, 0, exitCode, stderr)
+	var groups []map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(stdout), &groups))
+	assert.Len(t, groups, 2)
+
+	exitCode, stdout, stderr = runCLI(t, `{"secret_type": "arbitrary", "name": "my-secret", "payload": "my-payload"}`,
+		"-output", "json", "secrets", "create", "-file", "-")
+	require.Equal(t, 0, exitCode, stderr)
+	var secret map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(stdout), &secret))
+	id := secret["id"].(string)
+
+	exitCode, stdout, stderr = runCLI(t, "", "locks", "create", id, "-name", "my-lock")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Regexp(t, `(?m)^SECRET_ID\s+SECRET_NAME`, stdout)
+
+	exitCode, stdout, stderr = runCLI(t, "", "locks", "list", id)
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Regexp(t, `(?m)^my-lock\s+current\s+`, stdout)
+
+	exitCode, stdout, stderr = runCLI(t, "", "locks", "list")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Regexp(t, `(?m)^`+id+`\s+my-secret\s+arbitrary\s+`, stdout)
+}
+
+func TestConfigurations(t *testing.T) {
+	setUpServer(t)
+
+	exitCode, _, stderr := runCLI(t, `{"config_type": "iam_credentials_configuration", "name": "my-config", "api_key": "my-api-key"}`,
+		"configurations", "create", "-file", "-")
+	require.Equal(t, 0, exitCode, stderr)
+
+	exitCode, stdout, stderr := runCLI(t, "", "configurations", "list")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Regexp(t, `(?m)^my-config\s+iam_credentials_configuration\s+iam_credentials\s+`, stdout)
+
+	exitCode, stdout, stderr = runCLI(t, "", "-output", "json", "configurations", "get", "my-config")
+	require.Equal(t, 0, exitCode, stderr)
+	assert.Contains(t, stdout, `"name": "my-config"`)
+}
+
+func TestUsage(t *testing.T) {
+	setUpServer(t)
+
+	exitCode, _, stderr := runCLI(t, "")
+	assert.Equal(t, 0, exitCode)
+	assert.Contains(t, stderr, "secrets list")
+
+	exitCode, _, stderr = runCLI(t, "", "secrets", "delete")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "unknown command 'secrets delete'")
+
+	exitCode, _, stderr = runCLI(t, "", "-output", "xml", "secrets", "list")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "unknown output format 'xml'")
+
+	exitCode, _, stderr = runCLI(t, "", "versions", "get", "only-one-argument")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "Usage: smctl versions get <secret_id> <version_id>")
+
+	exitCode, _, _ = runCLI(t, "", "secrets", "create")
+	assert.Equal(t, 2, exitCode)
+
+	exitCode, _, stderr = runCLI(t, "{", "secrets", "create", "-file", "-")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "reading -")
+}
@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats.
+const (
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+	formatTable = "table"
+)
+
+// formatter writes the result of a command. "columns" are the fields that are
+// shown by the table format.
+type formatter func(w io.Writer, result interface{}, columns []string) error
+
+var formatters = map[string]formatter{
+	formatJSON:  writeJSON,
+	formatYAML:  writeYAML,
+	formatTable: writeTable,
+}
+
+// withColumns is returned by commands whose table columns depend on their arguments.
+type withColumns struct {
+	result  interface{}
+	columns []string
+}
+
+func writeJSON(w io.Writer, result interface{}, _ []string) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+func writeYAML(w io.Writer, result interface{}, _ []string) error {
+	// The models only have JSON tags, so YAML is converted from their JSON form.
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	encoded, err = yaml.JSONToYAML(encoded)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(encoded)
+	return err
+}
+
+func writeTable(w io.Writer, result interface{}, columns []string) error {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	// An empty result, such as the nil slice of a list that found nothing, has no rows.
+	var rows []map[string]interface{}
+	switch {
+	case bytes.Equal(encoded, []byte("null")):
+	case encoded[0] == '[':
+		err = json.Unmarshal(encoded, &rows)
+	default:
+		var row map[string]interface{}
+		err = json.Unmarshal(encoded, &row)
+		rows = append(rows, row)
+	}
+	if err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for i, column := range columns {
+			cells[i] = tableCell(row[column])
+		}
+		fmt.Fprintln(table, strings.Join(cells, "\t"))
+	}
+	return table.Flush()
+}
+
+// tableCell formats a field of a table row. Fields that are not strings are shown as JSON.
+func tableCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command smexec runs a command with secrets from Secrets Manager injected
+// into its environment.
+//
+// Usage:
+//
+//	smexec -secret NAME=REFERENCE [-secret ...] [-watch [-interval DURATION] [-signal SIGNAL]] -- COMMAND [ARGUMENTS]
+//
+// For example:
+//
+//	smexec -secret DB_PASS=kv/app/db#password -secret API_KEY=ibmsm://default/iam_credentials/ci -- ./server
+//
+// With -watch, the secrets are polled and the command is restarted when their
+// values change, or sent the signal of -signal instead. The client is
+// configured like NewSecretsManagerV2UsingExternalConfig, from the
+// environment variables of the service name, which defaults to
+// "secrets_manager". smexec exits with the exit code of the command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smexec"
+)
+
+// signals are the signals that can be sent to the command on changes.
+var signals = map[string]os.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"TERM": syscall.SIGTERM,
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs smexec with the command-line arguments "args" and returns the exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	options := &smexec.Options{Stdin: stdin, Stdout: stdout, Stderr: stderr}
+
+	flags := flag.NewFlagSet("smexec", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Func("secret", "an environment variable to inject, as NAME=REFERENCE (repeatable)", func(value string) error {
+		envSecret, err := smexec.ParseEnvSecret(value)
+		if err != nil {
+			return err
+		}
+		options.Secrets = append(options.Secrets, *envSecret)
+		return nil
+	})
+	flags.BoolVar(&options.Watch, "watch", false, "restart the command when the values of the secrets change")
+	flags.DurationVar(&options.WatchInterval, "interval", secretsmanagerv2.DefaultSecretWatcherInterval, "the polling interval of the watched secrets")
+	flags.Func("signal", "send this signal (HUP, INT, QUIT or TERM) instead of restarting the command", func(value string) (err error) {
+		var ok bool
+		if options.ReloadSignal, ok = signals[strings.TrimPrefix(strings.ToUpper(value), "SIG")]; !ok {
+			err = fmt.Errorf("unknown signal '%s'", value)
+		}
+		return
+	})
+	url := flags.String("url", "", "the URL of the Secrets Manager instance, which overrides the external configuration")
+	serviceName := flags.String("service-name", secretsmanagerv2.DefaultServiceName, "the service name of the external configuration")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: smexec -secret NAME=REFERENCE [flags] -- COMMAND [ARGUMENTS]\n\n")
+		fmt.Fprintf(stderr, "REFERENCE is <secret_type>/<secret_group_name>/<name>#<field> or an ibmsm:// secret URI.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	options.Command = flags.Args()
+	if len(options.Command) == 0 || len(options.Secrets) == 0 {
+		flags.Usage()
+		return 2
+	}
+	options.OnError = func(err error) {
+		fmt.Fprintf(stderr, "smexec: %s\n", err)
+	}
+
+	client, err := secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(&secretsmanagerv2.SecretsManagerV2Options{
+		ServiceName: *serviceName,
+		URL:         *url,
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "smexec: %s\n", err)
+		return 1
+	}
+
+	exitCode, err := smexec.Run(ctx, client, options)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintf(stderr, "smexec: %s\n", err)
+	}
+	if exitCode < 0 {
+		// The command could not be started, or was terminated by a signal.
+		return 1
+	}
+	return exitCode
+}
@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The test binary is also the command that is run by the tests when the
+// SMEXEC_TEST_HELPER environment variable is set.
+func TestMain(m *testing.M) {
+	if os.Getenv("SMEXEC_TEST_HELPER") != "" {
+		fmt.Printf("%s %s\n", os.Getenv("DB_PASS"), strings.Join(os.Args[1:], " "))
+		os.Exit(4)
+	}
+	os.Exit(m.Run())
+}
+
+func runSmexec(args ...string) (exitCode int, stdout string, stderr string) {
+	var stdoutBuffer, stderrBuffer bytes.Buffer
+	exitCode = run(context.Background(), args, strings.NewReader(""), &stdoutBuffer, &stderrBuffer)
+	return exitCode, stdoutBuffer.String(), stderrBuffer.String()
+}
+
+func TestRun(t *testing.T) {
+	server := smtest.NewServer()
+	t.Cleanup(server.Close)
+	t.Setenv("SECRETS_MANAGER_URL", server.URL)
+	t.Setenv("SECRETS_MANAGER_AUTH_TYPE", "noauth")
+	t.Setenv("SMEXEC_TEST_HELPER", "1")
+
+	client, err := server.NewClient()
+	require.Nil(t, err)
+	prototype, err := client.NewKVSecretPrototype(secretsmanagerv2.Secret_SecretType_Kv, "db", map[string]interface{}{"password": "my-password"})
+	require.Nil(t, err)
+	_, _, err = client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+
+	exitCode, stdout, stderr := runSmexec("-secret", "DB_PASS=kv/default/db#password", "--", os.Args[0], "-flag", "arg")
+	assert.Equal(t, 4, exitCode, stderr)
+	assert.Equal(t, "my-password -flag arg\n", stdout)
+
+	exitCode, _, stderr = runSmexec("-secret", "DB_PASS=kv/default/missing#password", "--", os.Args[0])
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "smexec: resolving DB_PASS")
+}
+
+func TestUsage(t *testing.T) {
+	exitCode, _, stderr := runSmexec("--", "./server")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "Usage: smexec")
+
+	exitCode, _, _ = runSmexec("-secret", "DB_PASS=kv/app/db#password")
+	assert.Equal(t, 2, exitCode)
+
+	exitCode, _, stderr = runSmexec("-secret", "DB_PASS", "--", "./server")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "must have the form '<name>=<reference>'")
+
+	exitCode, _, stderr = runSmexec("-secret", "DB_PASS=kv/app/db#password", "-signal", "usr9", "--", "./server")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "unknown signal 'usr9'")
+}
@@ -0,0 +1,336 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command mockgen generates the SecretsManagerV2Intf interface, its mock
+// implementation, the operations of the InstanceRouter and the table of the
+// request routes of the operations from the operations of the
+// SecretsManagerV2 client.
+//
+// Every method of SecretsManagerV2 that has a "WithContext" variant is an
+// operation. Run "go generate ./..." after the client is regenerated.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const header = `/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+`
+
+// operation is a method of the client, together with its "WithContext" variant.
+type operation struct {
+	name    string
+	doc     string
+	params  []param // The parameters of the method, without the context.
+	results []param
+	method  string // The HTTP method of the requests of the operation, for example "GET".
+	path    string // The path template of the requests of the operation, for example "/api/v2/secrets/{id}".
+}
+
+type param struct {
+	name     string
+	typeExpr string
+}
+
+// exportedIdent matches the unqualified exported identifiers in a type expression.
+var exportedIdent = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
+
+func main() {
+	source := flag.String("source", "", "the source file of the SecretsManagerV2 client")
+	interfaceFile := flag.String("interface", "", "the file to write the interface to")
+	mockFile := flag.String("mock", "", "the file to write the mock implementation to")
+	routerFile := flag.String("router", "", "the file to write the operations of the InstanceRouter to")
+	routesFile := flag.String("routes", "", "the file to write the request routes of the operations to")
+	flag.Parse()
+
+	operations, err := parseOperations(*source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = writeSource(*interfaceFile, generateInterface(operations)); err != nil {
+		log.Fatal(err)
+	}
+	if err = writeSource(*mockFile, generateMock(operations)); err != nil {
+		log.Fatal(err)
+	}
+	if err = writeSource(*routerFile, generateRouter(operations)); err != nil {
+		log.Fatal(err)
+	}
+	if err = writeSource(*routesFile, generateRoutes(operations)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseOperations(source string) (operations []operation, err error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, source, nil, parser.ParseComments)
+	if err != nil {
+		return
+	}
+
+	methods := map[string]*ast.FuncDecl{}
+	var names []string
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || !funcDecl.Name.IsExported() {
+			continue
+		}
+		if star, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr); !ok || star.X.(*ast.Ident).Name != "SecretsManagerV2" {
+			continue
+		}
+		methods[funcDecl.Name.Name] = funcDecl
+		names = append(names, funcDecl.Name.Name)
+	}
+
+	for _, name := range names {
+		withContext, ok := methods[name+"WithContext"]
+		if !ok {
+			continue
+		}
+		op := operation{name: name}
+		if methods[name].Doc != nil {
+			op.doc = strings.SplitN(methods[name].Doc.Text(), "\n", 2)[0]
+		}
+		op.params = fieldList(fileSet, withContext.Type.Params)[1:]
+		op.results = fieldList(fileSet, withContext.Type.Results)
+		op.method, op.path, err = requestRoute(withContext)
+		if err != nil {
+			return
+		}
+		operations = append(operations, op)
+	}
+	if len(operations) == 0 {
+		err = fmt.Errorf("no operations were found in %s", source)
+	}
+	return
+}
+
+// requestRoute returns the HTTP method and the path template of the request
+// that a method builds.
+func requestRoute(funcDecl *ast.FuncDecl) (method string, path string, err error) {
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fun, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case fun.Sel.Name == "NewRequestBuilder" && len(call.Args) == 1:
+			if arg, ok := call.Args[0].(*ast.SelectorExpr); ok {
+				method = arg.Sel.Name
+			}
+		case fun.Sel.Name == "ResolveRequestURL" && len(call.Args) == 3:
+			if arg, ok := call.Args[1].(*ast.BasicLit); ok && arg.Kind == token.STRING {
+				path = strings.Trim(arg.Value, "`\"")
+			}
+		}
+		return true
+	})
+	if method == "" || path == "" {
+		err = fmt.Errorf("the request route of %s was not found", funcDecl.Name.Name)
+	}
+	return
+}
+
+func fieldList(fileSet *token.FileSet, fields *ast.FieldList) (params []param) {
+	for _, field := range fields.List {
+		var typeExpr bytes.Buffer
+		_ = printer.Fprint(&typeExpr, fileSet, field.Type)
+		for _, name := range field.Names {
+			params = append(params, param{name.Name, typeExpr.String()})
+		}
+	}
+	return
+}
+
+// signature returns the parameters and results of the "WithContext" variant
+// of an operation, or of the plain variant if "withContext" is false.
+func (op operation) signature(withContext bool, qualify bool) string {
+	typeOf := func(p param) string {
+		if qualify {
+			return exportedIdent.ReplaceAllString(p.typeExpr, "${1}secretsmanagerv2.${2}")
+		}
+		return p.typeExpr
+	}
+	var params, results []string
+	if withContext {
+		params = append(params, "ctx context.Context")
+	}
+	for _, p := range op.params {
+		params = append(params, p.name+" "+typeOf(p))
+	}
+	for _, r := range op.results {
+		results = append(results, r.name+" "+typeOf(r))
+	}
+	return fmt.Sprintf("(%s) (%s)", strings.Join(params, ", "), strings.Join(results, ", "))
+}
+
+func (op operation) args(withContext bool) string {
+	var args []string
+	if withContext {
+		args = append(args, "ctx")
+	}
+	for _, p := range op.params {
+		args = append(args, p.name)
+	}
+	return strings.Join(args, ", ")
+}
+
+func generateInterface(operations []operation) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package secretsmanagerv2\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n)\n\n")
+	b.WriteString("// SecretsManagerV2Intf is the interface of the operations of the Secrets Manager API. It is implemented by\n")
+	b.WriteString("// SecretsManagerV2, and can be implemented by test doubles and by decorators that wrap a client.\n")
+	b.WriteString("type SecretsManagerV2Intf interface {\n")
+	for i, op := range operations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if op.doc != "" {
+			fmt.Fprintf(&b, "\t// %s\n", op.doc)
+		}
+		fmt.Fprintf(&b, "\t%s%s\n", op.name, op.signature(false, false))
+		fmt.Fprintf(&b, "\t%sWithContext%s\n", op.name, op.signature(true, false))
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("var _ SecretsManagerV2Intf = (*SecretsManagerV2)(nil)\n")
+	return b.Bytes()
+}
+
+func generateMock(operations []operation) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package smtest\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n\t\"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2\"\n)\n\n")
+	b.WriteString("// MockSecretsManagerV2 is a mock implementation of secretsmanagerv2.SecretsManagerV2Intf.\n")
+	b.WriteString("//\n")
+	b.WriteString("// Each operation is implemented by the function field that is named after it with a \"Func\" suffix. The\n")
+	b.WriteString("// plain variant of an operation calls the same function as its \"WithContext\" variant, with\n")
+	b.WriteString("// context.Background(). Operations without a function return an error. All calls are recorded.\n")
+	b.WriteString("type MockSecretsManagerV2 struct {\n")
+	for _, op := range operations {
+		fmt.Fprintf(&b, "\t%sFunc func%s\n", op.name, op.signature(true, true))
+	}
+	b.WriteString("\n\tcalls mockCalls\n")
+	b.WriteString("}\n\n")
+	b.WriteString("var _ secretsmanagerv2.SecretsManagerV2Intf = (*MockSecretsManagerV2)(nil)\n")
+	for _, op := range operations {
+		fmt.Fprintf(&b, "\n// %s calls %sFunc with context.Background().\n", op.name, op.name)
+		fmt.Fprintf(&b, "func (mock *MockSecretsManagerV2) %s%s {\n", op.name, op.signature(false, true))
+		fmt.Fprintf(&b, "\treturn mock.%sWithContext(%s)\n", op.name, strings.Replace(op.args(true), "ctx", "context.Background()", 1))
+		b.WriteString("}\n")
+
+		fmt.Fprintf(&b, "\n// %sWithContext calls %sFunc.\n", op.name, op.name)
+		fmt.Fprintf(&b, "func (mock *MockSecretsManagerV2) %sWithContext%s {\n", op.name, op.signature(true, true))
+		fmt.Fprintf(&b, "\tmock.calls.record(%q, %s)\n", op.name, op.params[0].name)
+		fmt.Fprintf(&b, "\tif mock.%sFunc == nil {\n", op.name)
+		fmt.Fprintf(&b, "\t\terr = errNotMocked(%q)\n", op.name)
+		b.WriteString("\t\treturn\n\t}\n")
+		fmt.Fprintf(&b, "\treturn mock.%sFunc(%s)\n", op.name, op.args(true))
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+func generateRouter(operations []operation) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package secretsmanagerv2\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n)\n")
+	for _, op := range operations {
+		route, description := "write", "the primary instance"
+		if op.method == "GET" {
+			route, description = "read", "the first healthy instance, with failover"
+		}
+
+		fmt.Fprintf(&b, "\n// %s sends the %s operation to %s.\n", op.name, op.name, description)
+		fmt.Fprintf(&b, "func (router *InstanceRouter) %s%s {\n", op.name, op.signature(false, false))
+		fmt.Fprintf(&b, "\t%s = router.%sWithContext(%s)\n", resultNames(op), op.name, strings.Replace(op.args(true), "ctx", "context.Background()", 1))
+		b.WriteString("\terr = core.RepurposeSDKProblem(err, \"\")\n")
+		b.WriteString("\treturn\n}\n")
+
+		fmt.Fprintf(&b, "\n// %sWithContext sends the %s operation to %s.\n", op.name, op.name, description)
+		fmt.Fprintf(&b, "func (router *InstanceRouter) %sWithContext%s {\n", op.name, op.signature(true, false))
+		fmt.Fprintf(&b, "\tresponse, err = router.%s(ctx, %q, func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {\n", route, op.name)
+		fmt.Fprintf(&b, "\t\t%s = client.%sWithContext(%s)\n", resultNames(op), op.name, op.args(true))
+		b.WriteString("\t\treturn response, err\n")
+		b.WriteString("\t})\n")
+		b.WriteString("\treturn\n}\n")
+	}
+	return b.Bytes()
+}
+
+func generateRoutes(operations []operation) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package secretsmanagerv2\n\n")
+	b.WriteString("// operationRoutes lists the HTTP method and the path template of the requests of each operation.\n")
+	b.WriteString("var operationRoutes = []operationRoute{\n")
+	for _, op := range operations {
+		fmt.Fprintf(&b, "\t{%q, %q, %q},\n", op.name, op.method, op.path)
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func resultNames(op operation) string {
+	var names []string
+	for _, r := range op.results {
+		names = append(names, r.name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func writeSource(path string, source []byte) error {
+	formatted, err := format.Source(source)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0644)
+}
@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// ScanCertificateExpiryOptions : The ScanCertificateExpiry options.
+type ScanCertificateExpiryOptions struct {
+	// Report the certificates that expire within this number of days, including the certificates that have already
+	// expired. If it is zero, every certificate is reported.
+	WithinDays int64
+
+	// Report only the certificates of these secret groups, by ID.
+	Groups []string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewScanCertificateExpiryOptions : Instantiate ScanCertificateExpiryOptions
+func (*SecretsManagerV2) NewScanCertificateExpiryOptions(withinDays int64) *ScanCertificateExpiryOptions {
+	return &ScanCertificateExpiryOptions{
+		WithinDays: withinDays,
+	}
+}
+
+// SetWithinDays : Allow user to set WithinDays
+func (options *ScanCertificateExpiryOptions) SetWithinDays(withinDays int64) *ScanCertificateExpiryOptions {
+	options.WithinDays = withinDays
+	return options
+}
+
+// SetGroups : Allow user to set Groups
+func (options *ScanCertificateExpiryOptions) SetGroups(groups []string) *ScanCertificateExpiryOptions {
+	options.Groups = groups
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ScanCertificateExpiryOptions) SetHeaders(param map[string]string) *ScanCertificateExpiryOptions {
+	options.Headers = param
+	return options
+}
+
+// CertificateExpiryReport : The certificates that expire within a number of days, sorted by expiration date.
+type CertificateExpiryReport struct {
+	// The date that the report was generated.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// The number of days of the report, or zero if every certificate is reported.
+	WithinDays int64 `json:"within_days"`
+
+	// The certificates, from the first to expire to the last.
+	Certificates []CertificateExpiry `json:"certificates"`
+}
+
+// CertificateExpiry : The expiration of a certificate secret.
+type CertificateExpiry struct {
+	// The ID of the secret.
+	SecretID string `json:"secret_id"`
+
+	// The name of the secret.
+	Name string `json:"name"`
+
+	// The type of the secret: `imported_cert`, `public_cert` or `private_cert`.
+	SecretType string `json:"secret_type"`
+
+	// The ID of the secret group of the secret.
+	SecretGroupID string `json:"secret_group_id"`
+
+	// The name of the secret group of the secret.
+	SecretGroupName string `json:"secret_group_name"`
+
+	// The common name of the certificate.
+	CommonName string `json:"common_name,omitempty"`
+
+	// The date that the certificate expires.
+	ExpirationDate time.Time `json:"expiration_date"`
+
+	// The number of whole days until the certificate expires. It is negative if the certificate has expired.
+	DaysRemaining int64 `json:"days_remaining"`
+
+	// Whether the certificate is rotated automatically by its rotation policy.
+	AutoRotate bool `json:"auto_rotate"`
+}
+
+// certificateExpiryCSVHeader lists the columns of CertificateExpiryReport.WriteCSV.
+var certificateExpiryCSVHeader = []string{
+	"secret_id", "name", "secret_type", "secret_group_id", "secret_group_name",
+	"common_name", "expiration_date", "days_remaining", "auto_rotate",
+}
+
+// WriteJSON writes the report to "w" as an indented JSON object.
+func (report *CertificateExpiryReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return core.SDKErrorf(err, "", "report-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteCSV writes the certificates of the report to "w" as CSV, with a header
+// row. Dates are formatted as RFC 3339.
+func (report *CertificateExpiryReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write(certificateExpiryCSVHeader)
+	for _, certificate := range report.Certificates {
+		_ = writer.Write([]string{
+			certificate.SecretID,
+			certificate.Name,
+			certificate.SecretType,
+			certificate.SecretGroupID,
+			certificate.SecretGroupName,
+			certificate.CommonName,
+			certificate.ExpirationDate.Format(time.RFC3339),
+			strconv.FormatInt(certificate.DaysRemaining, 10),
+			strconv.FormatBool(certificate.AutoRotate),
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return core.SDKErrorf(err, "", "report-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ScanCertificateExpiry : Report the certificates that expire within a number of days
+// List the imported, public and private certificate secrets page by page, and report the certificates that expire
+// within "scanCertificateExpiryOptions.WithinDays" days, sorted by expiration date. The expiration date of a
+// certificate is its "ExpirationDate", or the end of its "Validity"; certificates that have neither, such as public
+// certificates whose order is pending, are not reported.
+func (secretsManager *SecretsManagerV2) ScanCertificateExpiry(scanCertificateExpiryOptions *ScanCertificateExpiryOptions) (result *CertificateExpiryReport, err error) {
+	result, err = secretsManager.ScanCertificateExpiryWithContext(context.Background(), scanCertificateExpiryOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ScanCertificateExpiryWithContext is an alternate form of the ScanCertificateExpiry method which supports a Context parameter
+func (secretsManager *SecretsManagerV2) ScanCertificateExpiryWithContext(ctx context.Context, scanCertificateExpiryOptions *ScanCertificateExpiryOptions) (result *CertificateExpiryReport, err error) {
+	err = core.ValidateNotNil(scanCertificateExpiryOptions, "scanCertificateExpiryOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if scanCertificateExpiryOptions.WithinDays < 0 {
+		err = core.SDKErrorf(nil, "the 'scanCertificateExpiryOptions.WithinDays' field must not be negative", "invalid-within-days", common.GetComponentInfo())
+		return
+	}
+
+	groups, _, err := secretsManager.ListSecretGroupsWithContext(ctx, &ListSecretGroupsOptions{
+		Headers: scanCertificateExpiryOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-secret-groups-error")
+		return
+	}
+	groupNames := map[string]string{"default": "default"}
+	for _, group := range groups.SecretGroups {
+		if group.ID != nil && group.Name != nil {
+			groupNames[*group.ID] = *group.Name
+		}
+	}
+
+	pager, err := secretsManager.NewSecretsPager(&ListSecretsOptions{
+		SecretTypes: []string{
+			ListSecretsOptions_SecretTypes_ImportedCert,
+			ListSecretsOptions_SecretTypes_PublicCert,
+			ListSecretsOptions_SecretTypes_PrivateCert,
+		},
+		Groups:  scanCertificateExpiryOptions.Groups,
+		Headers: scanCertificateExpiryOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	result = &CertificateExpiryReport{
+		GeneratedAt:  now.UTC(),
+		WithinDays:   scanCertificateExpiryOptions.WithinDays,
+		Certificates: []CertificateExpiry{},
+	}
+	deadline := now.AddDate(0, 0, int(scanCertificateExpiryOptions.WithinDays))
+	for metadata, iterErr := range pager.Iterate(ctx) {
+		if iterErr != nil {
+			return nil, iterErr
+		}
+		expirationDate := modelTimeField(metadata, "ExpirationDate")
+		if validity, ok := modelField(metadata, "Validity"); ok && expirationDate.IsZero() {
+			expirationDate = modelTimeField(validity.Interface(), "NotAfter")
+		}
+		if expirationDate.IsZero() {
+			continue
+		}
+		if scanCertificateExpiryOptions.WithinDays > 0 && expirationDate.After(deadline) {
+			continue
+		}
+
+		secretGroupID := modelStringField(metadata, "SecretGroupID")
+		result.Certificates = append(result.Certificates, CertificateExpiry{
+			SecretID:        modelStringField(metadata, "ID"),
+			Name:            modelStringField(metadata, "Name"),
+			SecretType:      modelStringField(metadata, "SecretType"),
+			SecretGroupID:   secretGroupID,
+			SecretGroupName: groupNames[secretGroupID],
+			CommonName:      modelStringField(metadata, "CommonName"),
+			ExpirationDate:  expirationDate.UTC(),
+			DaysRemaining:   int64(math.Floor(expirationDate.Sub(now).Hours() / 24)),
+			AutoRotate:      certificateAutoRotate(metadata),
+		})
+	}
+
+	sort.SliceStable(result.Certificates, func(i, j int) bool {
+		a, b := result.Certificates[i], result.Certificates[j]
+		if !a.ExpirationDate.Equal(b.ExpirationDate) {
+			return a.ExpirationDate.Before(b.ExpirationDate)
+		}
+		if a.SecretGroupName != b.SecretGroupName {
+			return a.SecretGroupName < b.SecretGroupName
+		}
+		return a.Name < b.Name
+	})
+	return
+}
+
+// certificateAutoRotate returns whether the "AutoRotate" field of the
+// rotation policy of a certificate model is true. Imported certificates have
+// no rotation policy.
+func certificateAutoRotate(model interface{}) bool {
+	rotation, ok := modelField(model, "Rotation")
+	if !ok || rotation.IsNil() {
+		return false
+	}
+	autoRotate, ok := modelField(rotation.Interface(), "AutoRotate")
+	if !ok {
+		return false
+	}
+	value, isBool := autoRotate.Interface().(*bool)
+	return isBool && *value
+}
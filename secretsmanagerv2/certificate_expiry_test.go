@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ScanCertificateExpiry`, func() {
+	var server *smtest.Server
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+	var groupID string
+
+	createPrivateCertificate := func(name string, ttl string, autoRotate bool) {
+		prototype, err := secretsManagerService.NewPrivateCertificatePrototype(secretsmanagerv2.Secret_SecretType_PrivateCert, name, "my-template", name+".example.com")
+		Expect(err).To(BeNil())
+		prototype.TTL = core.StringPtr(ttl)
+		prototype.SecretGroupID = core.StringPtr(groupID)
+		prototype.Rotation = &secretsmanagerv2.CommonRotationPolicy{AutoRotate: core.BoolPtr(autoRotate), Interval: core.Int64Ptr(1), Unit: core.StringPtr("day")}
+		_, _, err = secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		server = smtest.NewServer()
+		var err error
+		secretsManagerService, err = server.NewClient()
+		Expect(err).To(BeNil())
+		group, _, err := secretsManagerService.CreateSecretGroup(secretsManagerService.NewCreateSecretGroupOptions("my-group"))
+		Expect(err).To(BeNil())
+		groupID = *group.ID
+
+		createPrivateCertificate("later", "2160h", false)
+		createPrivateCertificate("soon", "72h", true)
+		createPrivateCertificate("sooner", "36h", false)
+
+		imported, err := secretsManagerService.NewImportedCertificatePrototype(secretsmanagerv2.Secret_SecretType_ImportedCert, "imported")
+		Expect(err).To(BeNil())
+		imported.Certificate = core.StringPtr(newTestCertificate("imported.example.com", false, nil).certPEM)
+		_, _, err = secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(imported))
+		Expect(err).To(BeNil())
+
+		arbitrary, err := secretsManagerService.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+		Expect(err).To(BeNil())
+		_, _, err = secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(arbitrary))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Report the certificates that expire within a number of days`, func() {
+		report, err := secretsManagerService.ScanCertificateExpiry(secretsManagerService.NewScanCertificateExpiryOptions(30))
+		Expect(err).To(BeNil())
+		Expect(report.WithinDays).To(Equal(int64(30)))
+		Expect(report.Certificates).To(HaveLen(3))
+
+		// The test certificate expires in an hour.
+		Expect(report.Certificates[0].Name).To(Equal("imported"))
+		Expect(report.Certificates[0].SecretGroupName).To(Equal("default"))
+		Expect(report.Certificates[0].DaysRemaining).To(Equal(int64(0)))
+		Expect(report.Certificates[0].CommonName).To(Equal("imported.example.com"))
+		Expect(report.Certificates[1].Name).To(Equal("sooner"))
+		Expect(report.Certificates[1].DaysRemaining).To(Equal(int64(1)))
+		Expect(report.Certificates[1].AutoRotate).To(BeFalse())
+		Expect(report.Certificates[2].Name).To(Equal("soon"))
+		Expect(report.Certificates[2].SecretGroupName).To(Equal("my-group"))
+		Expect(report.Certificates[2].SecretType).To(Equal(secretsmanagerv2.Secret_SecretType_PrivateCert))
+		Expect(report.Certificates[2].DaysRemaining).To(Equal(int64(2)))
+		Expect(report.Certificates[2].AutoRotate).To(BeTrue())
+
+		report, err = secretsManagerService.ScanCertificateExpiry(secretsManagerService.NewScanCertificateExpiryOptions(0).SetGroups([]string{groupID}))
+		Expect(err).To(BeNil())
+		Expect(report.Certificates).To(HaveLen(3))
+		Expect(report.Certificates[2].Name).To(Equal("later"))
+	})
+	It(`Write the report as JSON and CSV`, func() {
+		report, err := secretsManagerService.ScanCertificateExpiry(secretsManagerService.NewScanCertificateExpiryOptions(2))
+		Expect(err).To(BeNil())
+		Expect(report.Certificates).To(HaveLen(2))
+
+		var jsonOutput bytes.Buffer
+		Expect(report.WriteJSON(&jsonOutput)).To(Succeed())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(jsonOutput.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded["certificates"]).To(HaveLen(2))
+		Expect(decoded["certificates"].([]interface{})[1]).To(HaveKeyWithValue("days_remaining", BeNumerically("==", 1)))
+
+		var csvOutput bytes.Buffer
+		Expect(report.WriteCSV(&csvOutput)).To(Succeed())
+		records, err := csv.NewReader(&csvOutput).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(3))
+		Expect(records[0]).To(ContainElements("name", "secret_group_name", "days_remaining", "auto_rotate"))
+		Expect(records[2][1]).To(Equal("sooner"))
+		Expect(records[2][7]).To(Equal("1"))
+		Expect(records[2][8]).To(Equal("false"))
+	})
+	It(`Invoke ScanCertificateExpiry with error`, func() {
+		_, err := secretsManagerService.ScanCertificateExpiry(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.ScanCertificateExpiry(secretsManagerService.NewScanCertificateExpiryOptions(-1))
+		Expect(err).To(MatchError(ContainSubstring("must not be negative")))
+	})
+})
@@ -0,0 +1,491 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- required by the JKS format.
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"hash"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// The iteration count of the PKCS#12 key derivations. It is the default of
+// OpenSSL 3.
+const pkcs12Iterations = 2048
+
+var (
+	oidData                = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedData       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+	oidPBES2               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256      = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC           = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidSHA256              = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidCertBag             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidPKCS8ShroudedKeyBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidX509Certificate     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidJKSKeyProtector     = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 42, 2, 17, 1, 1}
+	asn1Null               = asn1.RawValue{Tag: asn1.TagNull}
+)
+
+// The magic number at the start of a JKS keystore.
+const jksMagic uint32 = 0xFEEDFEED
+
+// The ASN.1 structures of RFC 7292 (PKCS#12) and RFC 8018 (PKCS#5).
+type pfxPDU struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type encryptedData struct {
+	Version              int
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm algorithmIdentifier
+	EncryptedContent           asn1.RawValue
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc algorithmIdentifier
+	EncryptionScheme  algorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	PRF            algorithmIdentifier
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     algorithmIdentifier
+	EncryptedData []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID     asn1.ObjectIdentifier
+	Values []asn1.RawValue `asn1:"set"`
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data asn1.RawValue
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type digestInfo struct {
+	Algorithm algorithmIdentifier
+	Digest    []byte
+}
+
+// ExportPKCS12 returns the certificate, its issuing certificates and the
+// private key of a certificate secret or secret version as a PKCS#12 bundle
+// that is protected by "password". The private key and the certificates are
+// encrypted with AES-256-CBC and PBKDF2-HMAC-SHA256, and the bundle is
+// authenticated with HMAC-SHA256, which is supported by OpenSSL 3 and Java 8u301
+// and later.
+//
+// The certificate and the key are named "alias"; if it is empty, the name of
+// the secret, or the common name of the certificate, is used.
+func ExportPKCS12(certificate interface{}, alias string, password string) (pfx []byte, err error) {
+	chain, keyDER, alias, err := certificateExportContent(certificate, alias, password)
+	if err != nil {
+		return
+	}
+	authenticatedSafe, err := pkcs12AuthenticatedSafe(chain, keyDER, alias, password)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "pkcs12-encode-error", common.GetComponentInfo())
+		return
+	}
+
+	macSalt := make([]byte, 16)
+	_, _ = rand.Read(macSalt)
+	macKey := pkcs12KDF(sha256.New, bmpString(password, true), macSalt, 3, pkcs12Iterations, sha256.Size)
+	mac := hmac.New(sha256.New, macKey)
+	mac.Write(authenticatedSafe)
+
+	pfx, err = asn1.Marshal(pfxPDU{
+		Version:  3,
+		AuthSafe: contentInfo{ContentType: oidData, Content: explicitTag(mustMarshal(authenticatedSafe))},
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: algorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1Null},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "pkcs12-encode-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ExportJKS returns the certificate, its issuing certificates and the private
+// key of a certificate secret or secret version as a Java KeyStore (JKS) with
+// a single private key entry. The keystore and the private key are both
+// protected by "password".
+//
+// The entry is named "alias", in lower case as Java expects; if it is empty,
+// the name of the secret, or the common name of the certificate, is used. The
+// alias must not exceed 65535 bytes in the modified UTF-8 encoding of Java.
+func ExportJKS(certificate interface{}, alias string, password string) (keystore []byte, err error) {
+	chain, keyDER, alias, err := certificateExportContent(certificate, alias, password)
+	if err != nil {
+		return
+	}
+	encodedAlias := javaUTF(strings.ToLower(alias))
+	if len(encodedAlias) > math.MaxUint16 {
+		err = core.SDKErrorf(nil, "the alias is too long for a JKS keystore", "jks-alias-too-long", common.GetComponentInfo())
+		return
+	}
+	protectedKey, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     algorithmIdentifier{Algorithm: oidJKSKeyProtector, Parameters: asn1Null},
+		EncryptedData: jksProtectKey(keyDER, password),
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "jks-encode-error", common.GetComponentInfo())
+		return
+	}
+
+	var buffer bytes.Buffer
+	writeUint32 := func(value uint32) { _ = binary.Write(&buffer, binary.BigEndian, value) }
+	writeUTF := func(encoded []byte) {
+		_ = binary.Write(&buffer, binary.BigEndian, uint16(len(encoded)))
+		buffer.Write(encoded)
+	}
+	writeUint32(jksMagic)
+	writeUint32(2) // The version of the format.
+	writeUint32(1) // The number of entries.
+	writeUint32(1) // A private key entry.
+	writeUTF(encodedAlias)
+	_ = binary.Write(&buffer, binary.BigEndian, time.Now().UnixMilli())
+	writeUint32(uint32(len(protectedKey)))
+	buffer.Write(protectedKey)
+	writeUint32(uint32(len(chain)))
+	for _, c := range chain {
+		writeUTF(javaUTF("X.509"))
+		writeUint32(uint32(len(c.Raw)))
+		buffer.Write(c.Raw)
+	}
+
+	digest := jksDigest(password)
+	digest.Write(buffer.Bytes())
+	keystore = digest.Sum(buffer.Bytes())
+	return
+}
+
+// pkcs12AuthenticatedSafe returns the content of a PKCS#12 bundle: the
+// certificates in an encrypted SafeContents, and the private key in a shrouded
+// key bag.
+func pkcs12AuthenticatedSafe(chain []*x509.Certificate, keyDER []byte, alias string, password string) ([]byte, error) {
+	localKeyID := sha1.Sum(chain[0].Raw) // #nosec G401 -- an identifier, not a signature.
+	attributes := []pkcs12Attribute{
+		{ID: oidFriendlyName, Values: []asn1.RawValue{{Tag: asn1.TagBMPString, Bytes: bmpString(alias, false)}}},
+		{ID: oidLocalKeyID, Values: []asn1.RawValue{{Tag: asn1.TagOctetString, Bytes: localKeyID[:]}}},
+	}
+
+	var certificateBags []safeBag
+	for i, c := range chain {
+		bag, err := asn1.Marshal(certBag{ID: oidX509Certificate, Data: explicitTag(mustMarshal(c.Raw))})
+		if err != nil {
+			return nil, err
+		}
+		certificateBag := safeBag{ID: oidCertBag, Value: explicitTag(bag)}
+		if i == 0 {
+			certificateBag.Attributes = attributes
+		}
+		certificateBags = append(certificateBags, certificateBag)
+	}
+	certificates, err := asn1.Marshal(certificateBags)
+	if err != nil {
+		return nil, err
+	}
+	algorithm, encryptedCertificates, err := pbes2Encrypt(certificates, password)
+	if err != nil {
+		return nil, err
+	}
+	certificatesData, err := asn1.Marshal(encryptedData{
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidData,
+			ContentEncryptionAlgorithm: algorithm,
+			EncryptedContent:           asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, Bytes: encryptedCertificates},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, encryptedKey, err := pbes2Encrypt(keyDER, password)
+	if err != nil {
+		return nil, err
+	}
+	keyBag, err := asn1.Marshal(encryptedPrivateKeyInfo{Algorithm: algorithm, EncryptedData: encryptedKey})
+	if err != nil {
+		return nil, err
+	}
+	keys, err := asn1.Marshal([]safeBag{{ID: oidPKCS8ShroudedKeyBag, Value: explicitTag(keyBag), Attributes: attributes}})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal([]contentInfo{
+		{ContentType: oidEncryptedData, Content: explicitTag(certificatesData)},
+		{ContentType: oidData, Content: explicitTag(mustMarshal(keys))},
+	})
+}
+
+// certificateExportContent returns the chain, the PKCS#8 private key and the
+// alias of a certificate model that is exported.
+func certificateExportContent(model interface{}, alias string, password string) (chain []*x509.Certificate, keyDER []byte, exportAlias string, err error) {
+	switch model.(type) {
+	case *PrivateCertificate, *PublicCertificate, *ImportedCertificate,
+		*PrivateCertificateVersion, *PublicCertificateVersion, *ImportedCertificateVersion:
+	default:
+		err = core.SDKErrorf(nil, "the secret is not a certificate", "not-a-certificate", common.GetComponentInfo())
+		return
+	}
+	if password == "" {
+		err = core.SDKErrorf(nil, "the password must not be empty", "empty-password", common.GetComponentInfo())
+		return
+	}
+	// certificateTLS verifies that the private key matches the certificate.
+	tlsCertificate, err := certificateTLS(model)
+	if err != nil {
+		return
+	}
+	chain, err = certificateChain(model)
+	if err != nil {
+		return
+	}
+	keyDER, err = x509.MarshalPKCS8PrivateKey(tlsCertificate.PrivateKey)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "private-key-encode-error", common.GetComponentInfo())
+		return
+	}
+
+	exportAlias = alias
+	for _, fieldName := range []string{"Name", "SecretName"} {
+		if exportAlias == "" {
+			exportAlias = modelStringField(model, fieldName)
+		}
+	}
+	if exportAlias == "" {
+		exportAlias = chain[0].Subject.CommonName
+	}
+	return
+}
+
+// pbes2Encrypt encrypts "data" with AES-256-CBC and a key that is derived from
+// "password" with PBKDF2-HMAC-SHA256, and returns the PBES2 algorithm.
+func pbes2Encrypt(data []byte, password string) (algorithm algorithmIdentifier, encrypted []byte, err error) {
+	salt := make([]byte, 16)
+	iv := make([]byte, aes.BlockSize)
+	_, _ = rand.Read(salt)
+	_, _ = rand.Read(iv)
+	key, err := pbkdf2.Key(sha256.New, password, salt, pkcs12Iterations, 32)
+	if err != nil {
+		return
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	encrypted = append(append([]byte{}, data...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	kdfParameters, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pkcs12Iterations,
+		PRF:            algorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1Null},
+	})
+	if err != nil {
+		return
+	}
+	parameters, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: algorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParameters}},
+		EncryptionScheme:  algorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: mustMarshal(iv)}},
+	})
+	algorithm = algorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: parameters}}
+	return
+}
+
+// pkcs12KDF derives "size" bytes from a password with the key derivation
+// function of RFC 7292 appendix B.2. "id" is 1 for encryption keys, 2 for
+// initialization vectors and 3 for MAC keys.
+func pkcs12KDF(newHash func() hash.Hash, password []byte, salt []byte, id byte, iterations int, size int) []byte {
+	h := newHash()
+	v := h.BlockSize()
+	fill := func(data []byte) []byte {
+		filled := make([]byte, v*((len(data)+v-1)/v))
+		for i := range filled {
+			filled[i] = data[i%len(data)]
+		}
+		return filled
+	}
+	input := append(fill(salt), fill(password)...)
+
+	var derived []byte
+	for len(derived) < size {
+		h.Reset()
+		h.Write(bytes.Repeat([]byte{id}, v))
+		h.Write(input)
+		a := h.Sum(nil)
+		for i := 1; i < iterations; i++ {
+			h.Reset()
+			h.Write(a)
+			a = h.Sum(a[:0])
+		}
+		derived = append(derived, a...)
+
+		// Add B+1 to each v-byte block of the input, modulo 2^(8v).
+		b := fill(a)
+		for j := 0; j < len(input); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				carry += int(input[j+k]) + int(b[k])
+				input[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+	return derived[:size]
+}
+
+// jksProtectKey encrypts a PKCS#8 private key with the proprietary key
+// protection algorithm of the JKS format: a SHA-1 keystream that is seeded
+// with a random salt, followed by a SHA-1 integrity check.
+func jksProtectKey(keyDER []byte, password string) []byte {
+	passwordBytes := bmpString(password, false)
+	salt := make([]byte, sha1.Size)
+	_, _ = rand.Read(salt)
+
+	protected := append([]byte{}, salt...)
+	digest := salt
+	for offset := 0; offset < len(keyDER); offset += sha1.Size {
+		h := sha1.New() // #nosec G401 -- required by the JKS format.
+		h.Write(passwordBytes)
+		h.Write(digest)
+		digest = h.Sum(nil)
+		for i := 0; i < sha1.Size && offset+i < len(keyDER); i++ {
+			protected = append(protected, keyDER[offset+i]^digest[i])
+		}
+	}
+
+	check := sha1.New() // #nosec G401 -- required by the JKS format.
+	check.Write(passwordBytes)
+	check.Write(keyDER)
+	return check.Sum(protected)
+}
+
+// jksDigest returns the hash of the integrity check of a JKS keystore, to
+// which the content of the keystore is written.
+func jksDigest(password string) hash.Hash {
+	digest := sha1.New() // #nosec G401 -- required by the JKS format.
+	digest.Write(bmpString(password, false))
+	digest.Write([]byte("Mighty Aphrodite"))
+	return digest
+}
+
+// bmpString encodes a string in UTF-16 big-endian, optionally followed by a
+// null terminator as PKCS#12 passwords are.
+func bmpString(s string, terminated bool) []byte {
+	var encoded []byte
+	for _, r := range utf16.Encode([]rune(s)) {
+		encoded = append(encoded, byte(r>>8), byte(r))
+	}
+	if terminated {
+		encoded = append(encoded, 0, 0)
+	}
+	return encoded
+}
+
+// javaUTF encodes "s" in the modified UTF-8 of Java's DataOutput.writeUTF:
+// each UTF-16 code unit, including each half of a surrogate pair, is encoded
+// separately, and NUL is encoded in two bytes.
+func javaUTF(s string) []byte {
+	var encoded []byte
+	for _, c := range utf16.Encode([]rune(s)) {
+		switch {
+		case c >= 0x01 && c <= 0x7F:
+			encoded = append(encoded, byte(c))
+		case c <= 0x7FF:
+			encoded = append(encoded, byte(0xC0|c>>6), byte(0x80|c&0x3F))
+		default:
+			encoded = append(encoded, byte(0xE0|c>>12), byte(0x80|c>>6&0x3F), byte(0x80|c&0x3F))
+		}
+	}
+	return encoded
+}
+
+// explicitTag wraps DER content in an [0] EXPLICIT tag.
+func explicitTag(content []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: content}
+}
+
+// mustMarshal encodes a value that always has an ASN.1 encoding, such as a
+// byte slice.
+func mustMarshal(value interface{}) []byte {
+	encoded, err := asn1.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// jksEntry is the private key entry of a JKS keystore that is decoded by readJKS.
+type jksEntry struct {
+	alias string
+	key   interface{}
+	chain []*x509.Certificate
+}
+
+// readJKS decodes a JKS keystore with a single private key entry.
+func readJKS(keystore []byte, password string) jksEntry {
+	var passwordBytes []byte
+	for _, r := range utf16.Encode([]rune(password)) {
+		passwordBytes = append(passwordBytes, byte(r>>8), byte(r))
+	}
+	content, digest := keystore[:len(keystore)-sha1.Size], keystore[len(keystore)-sha1.Size:]
+	expected := sha1.Sum(append(append(passwordBytes, []byte("Mighty Aphrodite")...), content...))
+	Expect(digest).To(Equal(expected[:]))
+
+	reader := bytes.NewReader(content)
+	readUint32 := func() uint32 {
+		var value uint32
+		Expect(binary.Read(reader, binary.BigEndian, &value)).To(Succeed())
+		return value
+	}
+	readBytes := func(n int) []byte {
+		data := make([]byte, n)
+		_, err := reader.Read(data)
+		Expect(err).To(BeNil())
+		return data
+	}
+	// readUTF decodes modified UTF-8 as Java's DataInput.readUTF does, which
+	// rejects the 4-byte sequences of standard UTF-8.
+	readUTF := func() string {
+		var length uint16
+		Expect(binary.Read(reader, binary.BigEndian, &length)).To(Succeed())
+		encoded := readBytes(int(length))
+		var units []uint16
+		for i := 0; i < len(encoded); {
+			switch b := encoded[i]; {
+			case b>>7 == 0:
+				units = append(units, uint16(b))
+				i++
+			case b>>5 == 0x6:
+				Expect(i + 1).To(BeNumerically("<", len(encoded)))
+				units = append(units, uint16(b&0x1F)<<6|uint16(encoded[i+1]&0x3F))
+				i += 2
+			case b>>4 == 0xE:
+				Expect(i + 2).To(BeNumerically("<", len(encoded)))
+				units = append(units, uint16(b&0x0F)<<12|uint16(encoded[i+1]&0x3F)<<6|uint16(encoded[i+2]&0x3F))
+				i += 3
+			default:
+				Fail(fmt.Sprintf("malformed modified UTF-8 byte 0x%02x", b))
+			}
+		}
+		return string(utf16.Decode(units))
+	}
+	Expect(readUint32()).To(Equal(uint32(0xFEEDFEED)))
+	Expect(readUint32()).To(Equal(uint32(2)))
+	Expect(readUint32()).To(Equal(uint32(1)))
+	Expect(readUint32()).To(Equal(uint32(1)))
+
+	var entry jksEntry
+	entry.alias = readUTF()
+	readBytes(8)
+	var protectedKey struct {
+		Algorithm struct {
+			Algorithm  asn1.ObjectIdentifier
+			Parameters asn1.RawValue `asn1:"optional"`
+		}
+		EncryptedData []byte
+	}
+	_, err := asn1.Unmarshal(readBytes(int(readUint32())), &protectedKey)
+	Expect(err).To(BeNil())
+	protected := protectedKey.EncryptedData
+	salt, encrypted, check := protected[:sha1.Size], protected[sha1.Size:len(protected)-sha1.Size], protected[len(protected)-sha1.Size:]
+	keyDER := make([]byte, len(encrypted))
+	digestValue := salt
+	for offset := 0; offset < len(encrypted); offset += sha1.Size {
+		sum := sha1.Sum(append(append([]byte{}, passwordBytes...), digestValue...))
+		digestValue = sum[:]
+		for i := 0; i < sha1.Size && offset+i < len(encrypted); i++ {
+			keyDER[offset+i] = encrypted[offset+i] ^ digestValue[i]
+		}
+	}
+	expectedCheck := sha1.Sum(append(append([]byte{}, passwordBytes...), keyDER...))
+	Expect(check).To(Equal(expectedCheck[:]))
+	entry.key, err = x509.ParsePKCS8PrivateKey(keyDER)
+	Expect(err).To(BeNil())
+
+	for n := readUint32(); n > 0; n-- {
+		Expect(readUTF()).To(Equal("X.509"))
+		certificate, err := x509.ParseCertificate(readBytes(int(readUint32())))
+		Expect(err).To(BeNil())
+		entry.chain = append(entry.chain, certificate)
+	}
+	Expect(reader.Len()).To(Equal(0))
+	return entry
+}
+
+var _ = Describe(`Certificate export`, func() {
+	var root, intermediate, leaf *testCertificate
+
+	BeforeEach(func() {
+		root = newTestCertificate("root.example.com", true, nil)
+		intermediate = newTestCertificate("intermediate.example.com", true, root)
+		leaf = newTestCertificate("leaf.example.com", false, intermediate)
+	})
+
+	It(`Export a private certificate version as a JKS keystore`, func() {
+		version := &secretsmanagerv2.PrivateCertificateVersion{
+			SecretName:  core.StringPtr("My-Cert"),
+			Certificate: core.StringPtr(leaf.certPEM),
+			PrivateKey:  core.StringPtr(leaf.keyPEM),
+			IssuingCa:   core.StringPtr(intermediate.certPEM),
+			CaChain:     []string{intermediate.certPEM, root.certPEM},
+		}
+		keystore, err := secretsmanagerv2.ExportJKS(version, "", "changeit")
+		Expect(err).To(BeNil())
+
+		entry := readJKS(keystore, "changeit")
+		Expect(entry.alias).To(Equal("my-cert"))
+		Expect(leaf.key.Equal(entry.key)).To(BeTrue())
+		Expect(entry.chain).To(HaveLen(3))
+		Expect(entry.chain[0].Equal(leaf.certificate)).To(BeTrue())
+		Expect(entry.chain[2].Equal(root.certificate)).To(BeTrue())
+	})
+	It(`Export a JKS keystore with an alias outside the Basic Multilingual Plane`, func() {
+		importedCertificate := &secretsmanagerv2.ImportedCertificate{
+			Certificate: core.StringPtr(leaf.certPEM),
+			PrivateKey:  core.StringPtr(leaf.keyPEM),
+		}
+		keystore, err := secretsmanagerv2.ExportJKS(importedCertificate, "Clé-\U0001F510", "changeit")
+		Expect(err).To(BeNil())
+		// U+1F510 is encoded as the surrogate pair D83D DD10, three bytes each.
+		Expect(bytes.Contains(keystore, []byte("cl\xc3\xa9-\xed\xa0\xbd\xed\xb4\x90"))).To(BeTrue())
+		Expect(readJKS(keystore, "changeit").alias).To(Equal("clé-\U0001F510"))
+
+		_, err = secretsmanagerv2.ExportJKS(importedCertificate, strings.Repeat("é", 40000), "changeit")
+		Expect(err).To(MatchError(ContainSubstring("the alias is too long")))
+	})
+	It(`Export an imported certificate as a PKCS#12 bundle`, func() {
+		opensslPath, err := exec.LookPath("openssl")
+		if err != nil {
+			Skip("openssl is not installed")
+		}
+		importedCertificate := &secretsmanagerv2.ImportedCertificate{
+			Certificate:  core.StringPtr(leaf.certPEM),
+			Intermediate: core.StringPtr(intermediate.certPEM + root.certPEM),
+			PrivateKey:   core.StringPtr(leaf.keyPEM),
+		}
+		pfx, err := secretsmanagerv2.ExportPKCS12(importedCertificate, "my-alias", "pässword")
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "pkcs12")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		pfxPath := filepath.Join(dir, "bundle.p12")
+		Expect(os.WriteFile(pfxPath, pfx, 0600)).To(Succeed())
+
+		output, err := exec.Command(opensslPath, "pkcs12", "-in", pfxPath, "-passin", "pass:pässword", "-nodes").CombinedOutput()
+		Expect(err).To(BeNil(), string(output))
+		Expect(strings.Count(string(output), "BEGIN CERTIFICATE")).To(Equal(3))
+		Expect(string(output)).To(ContainSubstring("BEGIN PRIVATE KEY"))
+		Expect(string(output)).To(ContainSubstring("friendlyName: my-alias"))
+		certificates, err := secretsmanagerv2.ParseCertificatesPEM(string(output))
+		Expect(err).To(BeNil())
+		Expect(certificates[0].Equal(leaf.certificate)).To(BeTrue())
+
+		_, err = exec.Command(opensslPath, "pkcs12", "-in", pfxPath, "-passin", "pass:wrong", "-nodes").CombinedOutput()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke ExportPKCS12 and ExportJKS with error`, func() {
+		publicCertificate := &secretsmanagerv2.PublicCertificate{
+			Certificate: core.StringPtr(leaf.certPEM),
+		}
+		_, err := secretsmanagerv2.ExportPKCS12(publicCertificate, "", "changeit")
+		Expect(err).To(MatchError(ContainSubstring("no private key")))
+
+		publicCertificate.PrivateKey = core.StringPtr(leaf.keyPEM)
+		_, err = secretsmanagerv2.ExportJKS(publicCertificate, "", "")
+		Expect(err).To(MatchError(ContainSubstring("the password must not be empty")))
+
+		_, err = secretsmanagerv2.ExportJKS(&secretsmanagerv2.ArbitrarySecret{}, "", "changeit")
+		Expect(err).To(MatchError(ContainSubstring("is not a certificate")))
+	})
+})
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"crypto/tls"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultCertificateRefreshInterval is the longest time between two refreshes
+// of a certificate that is used when CertificateReloaderOptions.RefreshInterval
+// is not set.
+const DefaultCertificateRefreshInterval = time.Hour
+
+// DefaultCertificateRetryInterval is the time between two attempts to refresh
+// a certificate after a failure that is used when
+// CertificateReloaderOptions.RetryInterval is not set.
+const DefaultCertificateRetryInterval = time.Minute
+
+// CertificateReloaderOptions : The options used to construct a CertificateReloader.
+type CertificateReloaderOptions struct {
+	// The ID of the private, public or imported certificate secret.
+	SecretID string `validate:"required,ne="`
+
+	// The longest time between two refreshes, so that a certificate that is
+	// rotated early is picked up. If it is zero, DefaultCertificateRefreshInterval is used.
+	RefreshInterval time.Duration
+
+	// How long before the end of the validity of the certificate it is
+	// refreshed. If it is zero, the certificate is refreshed when two thirds
+	// of its validity period have elapsed.
+	RefreshBefore time.Duration
+
+	// The time between two attempts to refresh the certificate after a failure,
+	// or while the certificate is due for renewal but has not been rotated yet.
+	// If it is zero, DefaultCertificateRetryInterval is used.
+	RetryInterval time.Duration
+
+	// The function that receives refresh errors. Errors are dropped if it is nil.
+	OnError func(err error)
+}
+
+// CertificateReloader serves the current version of a certificate secret to
+// TLS connections through the GetCertificate and GetClientCertificate
+// callbacks of a tls.Config.
+//
+// Refresh retrieves the certificate. Run refreshes it periodically, and
+// before the end of its validity period; if a refresh fails, the previous
+// certificate continues to be served and the refresh is retried.
+type CertificateReloader struct {
+	client          *SecretsManagerV2
+	secretID        string
+	refreshInterval time.Duration
+	refreshBefore   time.Duration
+	retryInterval   time.Duration
+	onError         func(err error)
+	current         atomic.Pointer[reloadedCertificate]
+	running         atomic.Bool
+}
+
+type reloadedCertificate struct {
+	certificate *tls.Certificate
+	notBefore   time.Time
+	notAfter    time.Time
+}
+
+// tlsCertificateSecret is implemented by the certificate secret models.
+type tlsCertificateSecret interface {
+	TLSCertificate() (tls.Certificate, error)
+}
+
+// NewCertificateReloader returns a new CertificateReloader instance.
+func (secretsManager *SecretsManagerV2) NewCertificateReloader(options *CertificateReloaderOptions) (reloader *CertificateReloader, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if options.RefreshInterval < 0 || options.RefreshBefore < 0 || options.RetryInterval < 0 {
+		err = core.SDKErrorf(nil, "the 'options.RefreshInterval', 'options.RefreshBefore' and 'options.RetryInterval' fields must not be negative", "invalid-refresh-interval", common.GetComponentInfo())
+		return
+	}
+
+	reloader = &CertificateReloader{
+		client:          secretsManager,
+		secretID:        options.SecretID,
+		refreshInterval: options.RefreshInterval,
+		refreshBefore:   options.RefreshBefore,
+		retryInterval:   options.RetryInterval,
+		onError:         options.OnError,
+	}
+	if reloader.refreshInterval == 0 {
+		reloader.refreshInterval = DefaultCertificateRefreshInterval
+	}
+	if reloader.retryInterval == 0 {
+		reloader.retryInterval = DefaultCertificateRetryInterval
+	}
+	return
+}
+
+// TLSConfig returns a new tls.Config that serves the certificate to TLS
+// clients and, for mutual TLS, to TLS servers.
+func (reloader *CertificateReloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		GetCertificate:       reloader.GetCertificate,
+		GetClientCertificate: reloader.GetClientCertificate,
+	}
+}
+
+// Certificate returns the certificate that is currently served, or nil if it
+// has not been retrieved yet.
+func (reloader *CertificateReloader) Certificate() *tls.Certificate {
+	if current := reloader.current.Load(); current != nil {
+		return current.certificate
+	}
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (reloader *CertificateReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return reloader.served()
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (reloader *CertificateReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return reloader.served()
+}
+
+func (reloader *CertificateReloader) served() (*tls.Certificate, error) {
+	certificate := reloader.Certificate()
+	if certificate == nil {
+		return nil, core.SDKErrorf(nil, "the certificate of secret '"+reloader.secretID+"' has not been retrieved", "certificate-not-loaded", common.GetComponentInfo())
+	}
+	return certificate, nil
+}
+
+// Refresh retrieves the current version of the certificate secret and serves
+// it. If it fails, the previous certificate continues to be served.
+func (reloader *CertificateReloader) Refresh(ctx context.Context) (err error) {
+	secret, _, err := reloader.client.GetSecretWithContext(ctx, &GetSecretOptions{
+		ID: &reloader.secretID,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "refresh-certificate-error")
+		return
+	}
+	certificateSecret, ok := secret.(tlsCertificateSecret)
+	if !ok {
+		err = core.SDKErrorf(nil, "the secret '"+reloader.secretID+"' is not a certificate", "not-a-certificate", common.GetComponentInfo())
+		return
+	}
+	certificate, err := certificateSecret.TLSCertificate()
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "refresh-certificate-error")
+		return
+	}
+
+	reloaded := &reloadedCertificate{
+		certificate: &certificate,
+		notBefore:   certificate.Leaf.NotBefore,
+		notAfter:    certificate.Leaf.NotAfter,
+	}
+	// The validity period of the secret is preferred, if it is set.
+	if validity, ok := modelField(secret, "Validity"); ok {
+		notBefore := modelTimeField(validity.Interface(), "NotBefore")
+		notAfter := modelTimeField(validity.Interface(), "NotAfter")
+		if !notBefore.IsZero() && !notAfter.IsZero() {
+			reloaded.notBefore, reloaded.notAfter = notBefore, notAfter
+		}
+	}
+	reloader.current.Store(reloaded)
+	return
+}
+
+// Run refreshes the certificate until "ctx" is cancelled, and then returns
+// the error of the context. The certificate is refreshed immediately if it
+// has not been retrieved yet. Run may only be invoked once for each
+// CertificateReloader.
+func (reloader *CertificateReloader) Run(ctx context.Context) (err error) {
+	if !reloader.running.CompareAndSwap(false, true) {
+		err = core.SDKErrorf(nil, "the reloader has already been started", "reloader-already-started", common.GetComponentInfo())
+		return
+	}
+
+	var next time.Time
+	if reloader.current.Load() != nil {
+		next = reloader.nextRefresh()
+	}
+	for {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if refreshErr := reloader.Refresh(ctx); refreshErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if reloader.onError != nil {
+				reloader.onError(refreshErr)
+			}
+			next = time.Now().Add(reloader.retryInterval)
+			continue
+		}
+		next = reloader.nextRefresh()
+	}
+}
+
+// nextRefresh returns the time of the next refresh of the current certificate.
+func (reloader *CertificateReloader) nextRefresh() time.Time {
+	now := time.Now()
+	current := reloader.current.Load()
+	refreshBefore := reloader.refreshBefore
+	if refreshBefore == 0 {
+		refreshBefore = current.notAfter.Sub(current.notBefore) / 3
+	}
+	renewal := current.notAfter.Add(-refreshBefore)
+	if !renewal.After(now) {
+		// The certificate is due for renewal but has not been rotated yet.
+		return now.Add(reloader.retryInterval)
+	}
+	if next := now.Add(reloader.refreshInterval); next.Before(renewal) {
+		return next
+	}
+	return renewal
+}
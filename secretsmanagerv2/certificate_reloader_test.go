@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CertificateReloader`, func() {
+	var server *smtest.Server
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+	var failing atomic.Bool
+	var errorCount atomic.Int64
+	var ctx context.Context
+	var cancel context.CancelFunc
+
+	createCertificate := func(ttl string) string {
+		prototype, err := secretsManagerService.NewPrivateCertificatePrototype(secretsmanagerv2.Secret_SecretType_PrivateCert, "my-cert", "my-template", "example.com")
+		Expect(err).To(BeNil())
+		prototype.TTL = core.StringPtr(ttl)
+		secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+		return *secret.(*secretsmanagerv2.PrivateCertificate).ID
+	}
+	rotate := func(secretID string) *x509.Certificate {
+		version, _, err := secretsManagerService.CreateSecretVersion(secretsManagerService.NewCreateSecretVersionOptions(secretID, &secretsmanagerv2.PrivateCertificateVersionPrototype{}))
+		Expect(err).To(BeNil())
+		certificates, err := secretsmanagerv2.ParseCertificatesPEM(*version.(*secretsmanagerv2.PrivateCertificateVersion).Certificate)
+		Expect(err).To(BeNil())
+		return certificates[0]
+	}
+	servedSerial := func(reloader *secretsmanagerv2.CertificateReloader) func() string {
+		return func() string {
+			certificate, err := reloader.GetCertificate(&tls.ClientHelloInfo{})
+			Expect(err).To(BeNil())
+			return certificate.Leaf.SerialNumber.String()
+		}
+	}
+
+	BeforeEach(func() {
+		failing.Store(false)
+		errorCount.Store(0)
+		server = smtest.NewUnstartedServer()
+		server.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if failing.Load() && req.Method == http.MethodGet {
+				res.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			server.ServeHTTP(res, req)
+		})
+		server.Start()
+		var err error
+		secretsManagerService, err = server.NewClient()
+		Expect(err).To(BeNil())
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It(`Serve the current version and pick up rotations`, func() {
+		secretID := createCertificate("1h")
+		reloader, err := secretsManagerService.NewCertificateReloader(&secretsmanagerv2.CertificateReloaderOptions{
+			SecretID:        secretID,
+			RefreshInterval: 20 * time.Millisecond,
+			RetryInterval:   20 * time.Millisecond,
+			OnError:         func(error) { errorCount.Add(1) },
+		})
+		Expect(err).To(BeNil())
+
+		_, err = reloader.GetCertificate(&tls.ClientHelloInfo{})
+		Expect(err).ToNot(BeNil())
+		Expect(reloader.Refresh(ctx)).To(BeNil())
+		initial := servedSerial(reloader)()
+		go reloader.Run(ctx)
+
+		rotated := rotate(secretID)
+		Eventually(servedSerial(reloader), 5*time.Second, 10*time.Millisecond).Should(Equal(rotated.SerialNumber.String()))
+
+		// Failed refreshes keep serving the previous version.
+		failing.Store(true)
+		rotate(secretID)
+		Eventually(errorCount.Load, 5*time.Second, 10*time.Millisecond).Should(BeNumerically(">=", 2))
+		Expect(servedSerial(reloader)()).To(Equal(rotated.SerialNumber.String()))
+		Expect(initial).ToNot(Equal(rotated.SerialNumber.String()))
+
+		clientCertificate, err := reloader.TLSConfig().GetClientCertificate(&tls.CertificateRequestInfo{})
+		Expect(err).To(BeNil())
+		Expect(clientCertificate).To(Equal(reloader.Certificate()))
+
+		Expect(reloader.Run(ctx)).ToNot(BeNil())
+	})
+	It(`Refresh before the end of the validity period`, func() {
+		secretID := createCertificate("3s")
+		reloader, err := secretsManagerService.NewCertificateReloader(&secretsmanagerv2.CertificateReloaderOptions{
+			SecretID:      secretID,
+			RefreshBefore: 2 * time.Second,
+			RetryInterval: 50 * time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+		go reloader.Run(ctx)
+		Eventually(reloader.Certificate, 5*time.Second, 10*time.Millisecond).ShouldNot(BeNil())
+
+		rotated := rotate(secretID)
+		// The refresh interval defaults to an hour, so only the validity period triggers the refresh.
+		Eventually(servedSerial(reloader), 3*time.Second, 10*time.Millisecond).Should(Equal(rotated.SerialNumber.String()))
+	})
+	It(`Invoke Refresh with error: the secret is not a certificate`, func() {
+		prototype, err := secretsManagerService.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+		Expect(err).To(BeNil())
+		secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+
+		reloader, err := secretsManagerService.NewCertificateReloader(&secretsmanagerv2.CertificateReloaderOptions{
+			SecretID: *secret.(*secretsmanagerv2.ArbitrarySecret).ID,
+		})
+		Expect(err).To(BeNil())
+		Expect(reloader.Refresh(ctx)).To(MatchError(ContainSubstring("is not a certificate")))
+	})
+	It(`Invoke NewCertificateReloader with error: invalid options`, func() {
+		_, err := secretsManagerService.NewCertificateReloader(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.NewCertificateReloader(&secretsmanagerv2.CertificateReloaderOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.NewCertificateReloader(&secretsmanagerv2.CertificateReloaderOptions{SecretID: "id", RetryInterval: -1})
+		Expect(err).ToNot(BeNil())
+	})
+})
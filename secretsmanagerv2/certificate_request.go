@@ -0,0 +1,527 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+var (
+	oidExtensionKeyUsage          = asn1.ObjectIdentifier{2, 5, 29, 15}
+	oidExtensionSubjectAltName    = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidExtensionCertificatePolicy = asn1.ObjectIdentifier{2, 5, 29, 32}
+	oidExtensionExtKeyUsage       = asn1.ObjectIdentifier{2, 5, 29, 37}
+	oidUserID                     = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+)
+
+// certificateKeyUsages maps the lower-case names of the x509.KeyUsage
+// constants, without the "KeyUsage" prefix, to their values.
+var certificateKeyUsages = map[string]x509.KeyUsage{
+	"digitalsignature":  x509.KeyUsageDigitalSignature,
+	"contentcommitment": x509.KeyUsageContentCommitment,
+	"keyencipherment":   x509.KeyUsageKeyEncipherment,
+	"dataencipherment":  x509.KeyUsageDataEncipherment,
+	"keyagreement":      x509.KeyUsageKeyAgreement,
+	"certsign":          x509.KeyUsageCertSign,
+	"crlsign":           x509.KeyUsageCRLSign,
+	"encipheronly":      x509.KeyUsageEncipherOnly,
+	"decipheronly":      x509.KeyUsageDecipherOnly,
+}
+
+// certificateExtKeyUsages maps the lower-case names of the x509.ExtKeyUsage
+// constants, without the "ExtKeyUsage" prefix, to their object identifiers.
+var certificateExtKeyUsages = map[string]asn1.ObjectIdentifier{
+	"any":                            {2, 5, 29, 37, 0},
+	"serverauth":                     {1, 3, 6, 1, 5, 5, 7, 3, 1},
+	"clientauth":                     {1, 3, 6, 1, 5, 5, 7, 3, 2},
+	"codesigning":                    {1, 3, 6, 1, 5, 5, 7, 3, 3},
+	"emailprotection":                {1, 3, 6, 1, 5, 5, 7, 3, 4},
+	"ipsecendsystem":                 {1, 3, 6, 1, 5, 5, 7, 3, 5},
+	"ipsectunnel":                    {1, 3, 6, 1, 5, 5, 7, 3, 6},
+	"ipsecuser":                      {1, 3, 6, 1, 5, 5, 7, 3, 7},
+	"timestamping":                   {1, 3, 6, 1, 5, 5, 7, 3, 8},
+	"ocspsigning":                    {1, 3, 6, 1, 5, 5, 7, 3, 9},
+	"microsoftservergatedcrypto":     {1, 3, 6, 1, 4, 1, 311, 10, 3, 3},
+	"netscapeservergatedcrypto":      {2, 16, 840, 1, 113730, 4, 1},
+	"microsoftcommercialcodesigning": {1, 3, 6, 1, 4, 1, 311, 2, 1, 22},
+	"microsoftkernelcodesigning":     {1, 3, 6, 1, 4, 1, 311, 61, 1, 1},
+}
+
+// GeneratedCertificateRequest : A certificate signing request (CSR) and its private key, which are generated locally.
+type GeneratedCertificateRequest struct {
+	// The PEM-encoded certificate signing request.
+	Csr string
+
+	// The PEM-encoded private key, in PKCS#8 format.
+	PrivateKey string
+}
+
+// GenerateCertificateRequest generates a private key and a certificate
+// signing request locally, from the fields that Secrets Manager uses to
+// generate the managed CSR of an imported certificate. The private key never
+// leaves the process.
+//
+// The private key is an RSA, EC or Ed25519 key, according to "KeyType" and
+// "KeyBits", which default to "rsa" and 2048 bits or 256 bits for EC keys.
+// The "KeyUsage", "ExtKeyUsage", "ExtKeyUsageOids", "PolicyIdentifiers" and
+// flag fields are requested as extensions of the certificate; the
+// certificate authority that signs the CSR can ignore them.
+func GenerateCertificateRequest(managedCsr *ImportedCertificateManagedCsr) (result *GeneratedCertificateRequest, err error) {
+	err = core.ValidateNotNil(managedCsr, "managedCsr cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	template, err := certificateRequestTemplate(managedCsr)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-csr-fields", common.GetComponentInfo())
+		return
+	}
+	key, err := generateCertificateKey(managedCsr)
+	if err != nil {
+		return
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "csr-generation-error", common.GetComponentInfo())
+		return
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "private-key-encode-error", common.GetComponentInfo())
+		return
+	}
+	result = &GeneratedCertificateRequest{
+		Csr:        string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+	}
+	return
+}
+
+// generateCertificateKey generates the private key of a certificate signing
+// request according to the "KeyType" and "KeyBits" fields.
+func generateCertificateKey(managedCsr *ImportedCertificateManagedCsr) (key crypto.Signer, err error) {
+	keyType := ImportedCertificateManagedCsr_KeyType_Rsa
+	if managedCsr.KeyType != nil {
+		keyType = *managedCsr.KeyType
+	}
+	var keyBits int64
+	if managedCsr.KeyBits != nil {
+		keyBits = *managedCsr.KeyBits
+	}
+
+	switch keyType {
+	case ImportedCertificateManagedCsr_KeyType_Rsa:
+		if keyBits == 0 {
+			keyBits = 2048
+		}
+		if keyBits != 2048 && keyBits != 3072 && keyBits != 4096 && keyBits != 8192 {
+			break
+		}
+		key, err = rsa.GenerateKey(rand.Reader, int(keyBits))
+	case ImportedCertificateManagedCsr_KeyType_Ec:
+		curves := map[int64]elliptic.Curve{0: elliptic.P256(), 224: elliptic.P224(), 256: elliptic.P256(), 384: elliptic.P384(), 521: elliptic.P521()}
+		curve, ok := curves[keyBits]
+		if !ok {
+			break
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case ImportedCertificateManagedCsr_KeyType_Ed25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("the key type '%s' is not supported", keyType), "invalid-key-type", common.GetComponentInfo())
+		return
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "private-key-generation-error", common.GetComponentInfo())
+		return
+	}
+	if key == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d bits are not supported for %s keys", keyBits, keyType), "invalid-key-bits", common.GetComponentInfo())
+	}
+	return
+}
+
+// certificateRequestTemplate returns the subject and the extensions of a
+// certificate signing request.
+func certificateRequestTemplate(managedCsr *ImportedCertificateManagedCsr) (*x509.CertificateRequest, error) {
+	commonName := ""
+	if managedCsr.CommonName != nil {
+		commonName = *managedCsr.CommonName
+	}
+	if commonName == "" && (managedCsr.RequireCn == nil || *managedCsr.RequireCn) {
+		return nil, fmt.Errorf("the common name is required unless 'require_cn' is false")
+	}
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:         commonName,
+			OrganizationalUnit: managedCsr.Ou,
+			Organization:       managedCsr.Organization,
+			Country:            managedCsr.Country,
+			Locality:           managedCsr.Locality,
+			Province:           managedCsr.Province,
+			StreetAddress:      managedCsr.StreetAddress,
+			PostalCode:         managedCsr.PostalCode,
+		},
+	}
+	for _, userID := range splitCommaList(managedCsr.UserIds) {
+		template.Subject.ExtraNames = append(template.Subject.ExtraNames, pkix.AttributeTypeAndValue{Type: oidUserID, Value: userID})
+	}
+
+	subjectAltName, err := certificateRequestSubjectAltName(managedCsr, commonName)
+	if err != nil {
+		return nil, err
+	}
+	if subjectAltName != nil {
+		template.ExtraExtensions = append(template.ExtraExtensions, *subjectAltName)
+	}
+
+	if managedCsr.KeyUsage != nil && *managedCsr.KeyUsage != "" {
+		var keyUsage x509.KeyUsage
+		for _, name := range splitCommaList(managedCsr.KeyUsage) {
+			usage, ok := certificateKeyUsages[strings.ToLower(name)]
+			if !ok {
+				return nil, fmt.Errorf("the key usage '%s' is not valid", name)
+			}
+			keyUsage |= usage
+		}
+		// The bits of the extension are numbered from the most significant
+		// bit of the first byte.
+		var bitString asn1.BitString
+		for bit := 0; bit < 9; bit++ {
+			if keyUsage&(1<<bit) != 0 {
+				for len(bitString.Bytes) <= bit/8 {
+					bitString.Bytes = append(bitString.Bytes, 0)
+				}
+				bitString.Bytes[bit/8] |= 0x80 >> (bit % 8)
+				bitString.BitLength = bit + 1
+			}
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: oidExtensionKeyUsage, Critical: true, Value: mustMarshal(bitString)})
+	}
+
+	var extKeyUsages []asn1.ObjectIdentifier
+	addExtKeyUsage := func(oid asn1.ObjectIdentifier) {
+		for _, usage := range extKeyUsages {
+			if usage.Equal(oid) {
+				return
+			}
+		}
+		extKeyUsages = append(extKeyUsages, oid)
+	}
+	for _, flag := range []struct {
+		value *bool
+		name  string
+	}{
+		{managedCsr.ServerFlag, "serverauth"},
+		{managedCsr.ClientFlag, "clientauth"},
+		{managedCsr.CodeSigningFlag, "codesigning"},
+		{managedCsr.EmailProtectionFlag, "emailprotection"},
+	} {
+		if flag.value != nil && *flag.value {
+			addExtKeyUsage(certificateExtKeyUsages[flag.name])
+		}
+	}
+	for _, name := range splitCommaList(managedCsr.ExtKeyUsage) {
+		oid, ok := certificateExtKeyUsages[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("the extended key usage '%s' is not valid", name)
+		}
+		addExtKeyUsage(oid)
+	}
+	for _, value := range splitCommaList(managedCsr.ExtKeyUsageOids) {
+		oid, err := parseObjectIdentifier(value)
+		if err != nil {
+			return nil, err
+		}
+		addExtKeyUsage(oid)
+	}
+	if len(extKeyUsages) > 0 {
+		template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: oidExtensionExtKeyUsage, Value: mustMarshal(extKeyUsages)})
+	}
+
+	var policies []struct{ Policy asn1.ObjectIdentifier }
+	for _, value := range splitCommaList(managedCsr.PolicyIdentifiers) {
+		oid, err := parseObjectIdentifier(value)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, struct{ Policy asn1.ObjectIdentifier }{oid})
+	}
+	if len(policies) > 0 {
+		template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: oidExtensionCertificatePolicy, Value: mustMarshal(policies)})
+	}
+	return template, nil
+}
+
+// certificateRequestSubjectAltName returns the subject alternative name
+// extension of a certificate signing request, or nil if it has no alternative
+// names. The extension is encoded here because crypto/x509 does not support
+// "otherName" alternative names.
+func certificateRequestSubjectAltName(managedCsr *ImportedCertificateManagedCsr, commonName string) (*pkix.Extension, error) {
+	var names []asn1.RawValue
+	seen := map[string]bool{}
+	addName := func(tag int, value []byte) {
+		key := fmt.Sprintf("%d/%x", tag, value)
+		if !seen[key] {
+			seen[key] = true
+			names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tag, Bytes: value})
+		}
+	}
+	// Names with an "@" are email addresses (tag 1), the others DNS names (tag 2).
+	addHostOrEmail := func(name string) {
+		if strings.Contains(name, "@") {
+			addName(1, []byte(name))
+		} else {
+			addName(2, []byte(name))
+		}
+	}
+
+	if commonName != "" && (managedCsr.ExcludeCnFromSans == nil || !*managedCsr.ExcludeCnFromSans) {
+		addHostOrEmail(commonName)
+	}
+	for _, name := range splitCommaList(managedCsr.AltNames) {
+		addHostOrEmail(name)
+	}
+	for _, value := range splitCommaList(managedCsr.IpSans) {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("the IP address '%s' is not valid", value)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		addName(7, ip)
+	}
+	for _, value := range splitCommaList(managedCsr.UriSans) {
+		uri, err := url.Parse(value)
+		if err != nil || uri.Scheme == "" {
+			return nil, fmt.Errorf("the URI '%s' is not valid", value)
+		}
+		addName(6, []byte(uri.String()))
+	}
+	for _, value := range splitCommaList(managedCsr.OtherSans) {
+		// The format is "<oid>:UTF8:<value>", or "<oid>;UTF8:<value>" as in OpenSSL.
+		separator := strings.IndexAny(value, ";:")
+		if separator < 0 || !strings.HasPrefix(strings.ToUpper(value[separator+1:]), "UTF8:") {
+			return nil, fmt.Errorf("the other SAN '%s' must have the form '<oid>:UTF8:<value>'", value)
+		}
+		oid, err := parseObjectIdentifier(value[:separator])
+		if err != nil {
+			return nil, err
+		}
+		otherValue := mustMarshal(asn1.RawValue{Tag: asn1.TagUTF8String, Bytes: []byte(value[separator+len(";UTF8:"):])})
+		otherName := append(mustMarshal(oid), mustMarshal(explicitTag(otherValue))...)
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: otherName})
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return &pkix.Extension{Id: oidExtensionSubjectAltName, Value: mustMarshal(names)}, nil
+}
+
+// parseObjectIdentifier parses a dotted object identifier, such as "1.2.3.4".
+func parseObjectIdentifier(value string) (oid asn1.ObjectIdentifier, err error) {
+	for _, part := range strings.Split(strings.TrimSpace(value), ".") {
+		var arc int
+		arc, err = strconv.Atoi(part)
+		if err != nil || arc < 0 {
+			return nil, fmt.Errorf("the object identifier '%s' is not valid", value)
+		}
+		oid = append(oid, arc)
+	}
+	if len(oid) < 2 {
+		return nil, fmt.Errorf("the object identifier '%s' is not valid", value)
+	}
+	return
+}
+
+// splitCommaList returns the trimmed, non-empty elements of a comma-delimited list.
+func splitCommaList(list *string) (elements []string) {
+	if list == nil {
+		return
+	}
+	for _, element := range strings.Split(*list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return
+}
+
+// SignCertificateRequestOptions : The SignCertificateRequest options.
+type SignCertificateRequestOptions struct {
+	// The name of the intermediate certificate authority configuration that signs the certificate.
+	ConfigName string `validate:"required"`
+
+	// The fields of the certificate signing request that is generated locally.
+	ManagedCsr *ImportedCertificateManagedCsr `validate:"required"`
+
+	// The time-to-live (TTL) to assign to the signed certificate, for example "8760h".
+	TTL *string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSignCertificateRequestOptions : Instantiate SignCertificateRequestOptions
+func (*SecretsManagerV2) NewSignCertificateRequestOptions(configName string, managedCsr *ImportedCertificateManagedCsr) *SignCertificateRequestOptions {
+	return &SignCertificateRequestOptions{
+		ConfigName: configName,
+		ManagedCsr: managedCsr,
+	}
+}
+
+// SetConfigName : Allow user to set ConfigName
+func (options *SignCertificateRequestOptions) SetConfigName(configName string) *SignCertificateRequestOptions {
+	options.ConfigName = configName
+	return options
+}
+
+// SetManagedCsr : Allow user to set ManagedCsr
+func (options *SignCertificateRequestOptions) SetManagedCsr(managedCsr *ImportedCertificateManagedCsr) *SignCertificateRequestOptions {
+	options.ManagedCsr = managedCsr
+	return options
+}
+
+// SetTTL : Allow user to set TTL
+func (options *SignCertificateRequestOptions) SetTTL(ttl string) *SignCertificateRequestOptions {
+	options.TTL = core.StringPtr(ttl)
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SignCertificateRequestOptions) SetHeaders(param map[string]string) *SignCertificateRequestOptions {
+	options.Headers = param
+	return options
+}
+
+// SignedCertificate : A certificate that is signed by a private certificate authority, with the private key and the
+// certificate signing request that are generated locally.
+//
+// The certificate, its issuing certificates and its private key can be imported with an ImportedCertificatePrototype.
+type SignedCertificate struct {
+	// The PEM-encoded certificate.
+	Certificate *string
+
+	// The PEM-encoded certificate of the certificate authority that signed the certificate.
+	IssuingCa *string
+
+	// The chain of certificate authorities that are associated with the certificate.
+	CaChain []string
+
+	// The date that the certificate expires, in seconds since the epoch.
+	Expiration *int64
+
+	// The PEM-encoded private key, in PKCS#8 format.
+	PrivateKey *string
+
+	// The PEM-encoded certificate signing request.
+	Csr *string
+}
+
+// SignCertificateRequest : Sign a certificate signing request that is generated locally
+// Generate a private key and a certificate signing request locally with GenerateCertificateRequest, and sign the
+// request with the "private_cert_configuration_action_sign_csr" action of an intermediate certificate authority
+// configuration. The subject and the alternative names of the signed certificate are those of "ManagedCsr".
+func (secretsManager *SecretsManagerV2) SignCertificateRequest(signCertificateRequestOptions *SignCertificateRequestOptions) (result *SignedCertificate, response *core.DetailedResponse, err error) {
+	result, response, err = secretsManager.SignCertificateRequestWithContext(context.Background(), signCertificateRequestOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SignCertificateRequestWithContext is an alternate form of the SignCertificateRequest method which supports a Context parameter
+func (secretsManager *SecretsManagerV2) SignCertificateRequestWithContext(ctx context.Context, signCertificateRequestOptions *SignCertificateRequestOptions) (result *SignedCertificate, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(signCertificateRequestOptions, "signCertificateRequestOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(signCertificateRequestOptions, "signCertificateRequestOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	managedCsr := signCertificateRequestOptions.ManagedCsr
+	generated, err := GenerateCertificateRequest(managedCsr)
+	if err != nil {
+		return
+	}
+	prototype := &PrivateCertificateConfigurationActionSignCSRPrototype{
+		ActionType:        core.StringPtr(ConfigurationAction_ActionType_PrivateCertConfigurationActionSignCsr),
+		Csr:               core.StringPtr(generated.Csr),
+		CommonName:        managedCsr.CommonName,
+		AltNames:          splitCommaList(managedCsr.AltNames),
+		IpSans:            managedCsr.IpSans,
+		UriSans:           managedCsr.UriSans,
+		OtherSans:         splitCommaList(managedCsr.OtherSans),
+		ExcludeCnFromSans: managedCsr.ExcludeCnFromSans,
+		Ou:                managedCsr.Ou,
+		Organization:      managedCsr.Organization,
+		Country:           managedCsr.Country,
+		Locality:          managedCsr.Locality,
+		Province:          managedCsr.Province,
+		StreetAddress:     managedCsr.StreetAddress,
+		PostalCode:        managedCsr.PostalCode,
+		TTL:               signCertificateRequestOptions.TTL,
+		Format:            core.StringPtr(PrivateCertificateConfigurationActionSignCSRPrototype_Format_Pem),
+	}
+	action, response, err := secretsManager.CreateConfigurationActionWithContext(ctx, &CreateConfigurationActionOptions{
+		Name:                  core.StringPtr(signCertificateRequestOptions.ConfigName),
+		ConfigActionPrototype: prototype,
+		Headers:               signCertificateRequestOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "sign-csr-error")
+		return
+	}
+	signCSR, ok := action.(*PrivateCertificateConfigurationActionSignCSR)
+	if !ok || signCSR.Data == nil || signCSR.Data.Certificate == nil {
+		err = core.SDKErrorf(nil, "the response of the sign CSR action has no certificate", "signed-certificate-not-found", common.GetComponentInfo())
+		return
+	}
+	result = &SignedCertificate{
+		Certificate: signCSR.Data.Certificate,
+		IssuingCa:   signCSR.Data.IssuingCa,
+		CaChain:     signCSR.Data.CaChain,
+		Expiration:  signCSR.Data.Expiration,
+		PrivateKey:  core.StringPtr(generated.PrivateKey),
+		Csr:         core.StringPtr(generated.Csr),
+	}
+	return
+}
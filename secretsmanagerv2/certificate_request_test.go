@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// parseCertificateRequest parses a PEM-encoded certificate signing request and checks its signature.
+func parseCertificateRequest(csrPEM string) *x509.CertificateRequest {
+	block, _ := pem.Decode([]byte(csrPEM))
+	Expect(block).ToNot(BeNil())
+	Expect(block.Type).To(Equal("CERTIFICATE REQUEST"))
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	Expect(err).To(BeNil())
+	Expect(request.CheckSignature()).To(Succeed())
+	return request
+}
+
+var _ = Describe(`Certificate requests`, func() {
+	It(`Generate a certificate request with the managed CSR fields`, func() {
+		generated, err := secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName:          core.StringPtr("example.com"),
+			AltNames:            core.StringPtr("www.example.com, admin@example.com"),
+			IpSans:              core.StringPtr("10.0.0.1,::1"),
+			UriSans:             core.StringPtr("spiffe://example.com/app"),
+			OtherSans:           core.StringPtr("1.3.6.1.4.1.311.20.2.3;UTF8:user@example.com"),
+			Organization:        []string{"IBM"},
+			Country:             []string{"US"},
+			UserIds:             core.StringPtr("user1"),
+			KeyType:             core.StringPtr(secretsmanagerv2.ImportedCertificateManagedCsr_KeyType_Ec),
+			KeyBits:             core.Int64Ptr(384),
+			KeyUsage:            core.StringPtr("DigitalSignature,KeyEncipherment"),
+			ExtKeyUsage:         core.StringPtr("ServerAuth"),
+			ExtKeyUsageOids:     core.StringPtr("1.3.6.1.5.5.7.3.9"),
+			ClientFlag:          core.BoolPtr(true),
+			PolicyIdentifiers:   core.StringPtr("1.2.3.4"),
+			ServerFlag:          core.BoolPtr(false),
+			EmailProtectionFlag: core.BoolPtr(false),
+		})
+		Expect(err).To(BeNil())
+
+		request := parseCertificateRequest(generated.Csr)
+		Expect(request.Subject.CommonName).To(Equal("example.com"))
+		Expect(request.Subject.Organization).To(Equal([]string{"IBM"}))
+		Expect(request.Subject.Country).To(Equal([]string{"US"}))
+		Expect(request.Subject.String()).To(ContainSubstring("0.9.2342.19200300.100.1.1=user1"))
+		Expect(request.DNSNames).To(Equal([]string{"example.com", "www.example.com"}))
+		Expect(request.EmailAddresses).To(Equal([]string{"admin@example.com"}))
+		Expect(request.IPAddresses).To(HaveLen(2))
+		Expect(request.URIs[0].String()).To(Equal("spiffe://example.com/app"))
+		publicKey, ok := request.PublicKey.(*ecdsa.PublicKey)
+		Expect(ok).To(BeTrue())
+		Expect(publicKey.Curve.Params().BitSize).To(Equal(384))
+
+		extensions := map[string][]byte{}
+		for _, extension := range request.Extensions {
+			extensions[extension.Id.String()] = extension.Value
+		}
+		Expect(extensions).To(HaveKey("2.5.29.15"))
+		Expect(extensions).To(HaveKey("2.5.29.32"))
+		var extKeyUsages []asn1.ObjectIdentifier
+		_, err = asn1.Unmarshal(extensions["2.5.29.37"], &extKeyUsages)
+		Expect(err).To(BeNil())
+		Expect(extKeyUsages).To(HaveLen(3))
+		Expect(string(extensions["2.5.29.17"])).To(ContainSubstring("user@example.com"))
+
+		block, _ := pem.Decode([]byte(generated.PrivateKey))
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		Expect(err).To(BeNil())
+		Expect(key.(*ecdsa.PrivateKey).PublicKey.Equal(publicKey)).To(BeTrue())
+	})
+	It(`Generate RSA and Ed25519 keys`, func() {
+		generated, err := secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName:        core.StringPtr("example.com"),
+			ExcludeCnFromSans: core.BoolPtr(true),
+		})
+		Expect(err).To(BeNil())
+		request := parseCertificateRequest(generated.Csr)
+		Expect(request.PublicKey.(*rsa.PublicKey).Size()).To(Equal(256))
+		Expect(request.DNSNames).To(BeEmpty())
+
+		generated, err = secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			RequireCn: core.BoolPtr(false),
+			AltNames:  core.StringPtr("example.com"),
+			KeyType:   core.StringPtr(secretsmanagerv2.ImportedCertificateManagedCsr_KeyType_Ed25519),
+		})
+		Expect(err).To(BeNil())
+		request = parseCertificateRequest(generated.Csr)
+		Expect(request.PublicKey).To(BeAssignableToTypeOf(ed25519.PublicKey{}))
+		Expect(request.Subject.CommonName).To(BeEmpty())
+	})
+	It(`Invoke GenerateCertificateRequest with error`, func() {
+		_, err := secretsmanagerv2.GenerateCertificateRequest(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{})
+		Expect(err).To(MatchError(ContainSubstring("the common name is required")))
+		_, err = secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName: core.StringPtr("example.com"),
+			KeyType:    core.StringPtr(secretsmanagerv2.ImportedCertificateManagedCsr_KeyType_Rsa),
+			KeyBits:    core.Int64Ptr(1024),
+		})
+		Expect(err).To(MatchError(ContainSubstring("1024 bits are not supported for rsa keys")))
+		_, err = secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName: core.StringPtr("example.com"),
+			KeyUsage:   core.StringPtr("DigitalSignature,Unknown"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("the key usage 'Unknown' is not valid")))
+		_, err = secretsmanagerv2.GenerateCertificateRequest(&secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName: core.StringPtr("example.com"),
+			OtherSans:  core.StringPtr("1.2.3.4:INT:5"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("must have the form")))
+	})
+	It(`Sign a certificate request with a certificate authority configuration`, func() {
+		server := smtest.NewServer()
+		defer server.Close()
+		secretsManagerService, err := server.NewClient()
+		Expect(err).To(BeNil())
+		prototype, err := secretsManagerService.NewPrivateCertificateConfigurationRootCAPrototype(secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationRootCa, "my-root-ca", "8760h", "Example Root CA")
+		Expect(err).To(BeNil())
+		_, _, err = secretsManagerService.CreateConfiguration(secretsManagerService.NewCreateConfigurationOptions(prototype))
+		Expect(err).To(BeNil())
+
+		signCertificateRequestOptions := secretsManagerService.NewSignCertificateRequestOptions("my-root-ca", &secretsmanagerv2.ImportedCertificateManagedCsr{
+			CommonName: core.StringPtr("example.com"),
+			AltNames:   core.StringPtr("www.example.com"),
+		}).SetTTL("24h")
+		signedCertificate, _, err := secretsManagerService.SignCertificateRequest(signCertificateRequestOptions)
+		Expect(err).To(BeNil())
+
+		importedCertificate := &secretsmanagerv2.ImportedCertificate{
+			Certificate:  signedCertificate.Certificate,
+			Intermediate: signedCertificate.IssuingCa,
+			PrivateKey:   signedCertificate.PrivateKey,
+		}
+		tlsCertificate, err := importedCertificate.TLSCertificate()
+		Expect(err).To(BeNil())
+		Expect(tlsCertificate.Leaf.Subject.CommonName).To(Equal("example.com"))
+		Expect(tlsCertificate.Leaf.DNSNames).To(ContainElement("www.example.com"))
+		Expect(tlsCertificate.Leaf.Issuer.CommonName).To(Equal("Example Root CA"))
+		_, err = importedCertificate.VerifyChain(nil)
+		Expect(err).To(BeNil())
+
+		_, _, err = secretsManagerService.SignCertificateRequest(signCertificateRequestOptions.SetConfigName("missing"))
+		Expect(err).ToNot(BeNil())
+		_, _, err = secretsManagerService.SignCertificateRequest(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
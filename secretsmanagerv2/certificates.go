@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// The certificate secret models hold their certificates and private key as
+// PEM strings: "Certificate", "PrivateKey", "Intermediate" (public and
+// imported certificates) and "IssuingCa" and "CaChain" (private
+// certificates). The methods in this file parse them into crypto/tls and
+// crypto/x509 objects. The secrets must be retrieved with their payload, for
+// example with "GetSecret".
+
+// X509Certificate : Parse the certificate of the secret.
+func (privateCertificate *PrivateCertificate) X509Certificate() (*x509.Certificate, error) {
+	return certificateLeaf(privateCertificate)
+}
+
+// X509Chain : Parse the certificate of the secret, followed by its issuing certificates.
+func (privateCertificate *PrivateCertificate) X509Chain() ([]*x509.Certificate, error) {
+	return certificateChain(privateCertificate)
+}
+
+// CACertPool : Return a pool of the issuing certificates of the secret.
+func (privateCertificate *PrivateCertificate) CACertPool() (*x509.CertPool, error) {
+	return certificateCAPool(privateCertificate)
+}
+
+// TLSCertificate : Return the certificate, its issuing certificates and the private key of the secret as a tls.Certificate.
+func (privateCertificate *PrivateCertificate) TLSCertificate() (tls.Certificate, error) {
+	return certificateTLS(privateCertificate)
+}
+
+// VerifyChain : Verify the certificate of the secret against its issuing certificates.
+func (privateCertificate *PrivateCertificate) VerifyChain(roots *x509.CertPool) ([][]*x509.Certificate, error) {
+	return certificateVerify(privateCertificate, roots)
+}
+
+// X509Certificate : Parse the certificate of the secret.
+func (publicCertificate *PublicCertificate) X509Certificate() (*x509.Certificate, error) {
+	return certificateLeaf(publicCertificate)
+}
+
+// X509Chain : Parse the certificate of the secret, followed by its issuing certificates.
+func (publicCertificate *PublicCertificate) X509Chain() ([]*x509.Certificate, error) {
+	return certificateChain(publicCertificate)
+}
+
+// CACertPool : Return a pool of the issuing certificates of the secret.
+func (publicCertificate *PublicCertificate) CACertPool() (*x509.CertPool, error) {
+	return certificateCAPool(publicCertificate)
+}
+
+// TLSCertificate : Return the certificate, its issuing certificates and the private key of the secret as a tls.Certificate.
+func (publicCertificate *PublicCertificate) TLSCertificate() (tls.Certificate, error) {
+	return certificateTLS(publicCertificate)
+}
+
+// VerifyChain : Verify the certificate of the secret against its issuing certificates.
+func (publicCertificate *PublicCertificate) VerifyChain(roots *x509.CertPool) ([][]*x509.Certificate, error) {
+	return certificateVerify(publicCertificate, roots)
+}
+
+// X509Certificate : Parse the certificate of the secret.
+func (importedCertificate *ImportedCertificate) X509Certificate() (*x509.Certificate, error) {
+	return certificateLeaf(importedCertificate)
+}
+
+// X509Chain : Parse the certificate of the secret, followed by its issuing certificates.
+func (importedCertificate *ImportedCertificate) X509Chain() ([]*x509.Certificate, error) {
+	return certificateChain(importedCertificate)
+}
+
+// CACertPool : Return a pool of the issuing certificates of the secret.
+func (importedCertificate *ImportedCertificate) CACertPool() (*x509.CertPool, error) {
+	return certificateCAPool(importedCertificate)
+}
+
+// TLSCertificate : Return the certificate, its issuing certificates and the private key of the secret as a tls.Certificate.
+func (importedCertificate *ImportedCertificate) TLSCertificate() (tls.Certificate, error) {
+	return certificateTLS(importedCertificate)
+}
+
+// VerifyChain : Verify the certificate of the secret against its issuing certificates.
+func (importedCertificate *ImportedCertificate) VerifyChain(roots *x509.CertPool) ([][]*x509.Certificate, error) {
+	return certificateVerify(importedCertificate, roots)
+}
+
+// ParseCertificatesPEM parses all the "CERTIFICATE" blocks of a PEM string.
+// Blocks of other types are ignored.
+func ParseCertificatesPEM(pemData string) (certificates []*x509.Certificate, err error) {
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		var certificate *x509.Certificate
+		certificate, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "certificate-parse-error", common.GetComponentInfo())
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	return
+}
+
+// certificateLeaf parses the "Certificate" field of a certificate model.
+func certificateLeaf(model interface{}) (*x509.Certificate, error) {
+	certificates, err := ParseCertificatesPEM(modelStringField(model, "Certificate"))
+	if err != nil {
+		return nil, err
+	}
+	if len(certificates) == 0 {
+		return nil, core.SDKErrorf(nil, "the secret has no certificate; it must be retrieved with its payload", "certificate-not-found", common.GetComponentInfo())
+	}
+	return certificates[0], nil
+}
+
+// certificateIssuers parses the issuing certificates of a certificate model,
+// in the order of its "Intermediate", "IssuingCa" and "CaChain" fields,
+// without duplicates and without the certificate itself.
+func certificateIssuers(model interface{}, leaf *x509.Certificate) (issuers []*x509.Certificate, err error) {
+	pemData := []string{modelStringField(model, "Intermediate"), modelStringField(model, "IssuingCa")}
+	if caChain, ok := modelField(model, "CaChain"); ok {
+		pemData = append(pemData, caChain.Interface().([]string)...)
+	}
+	seen := [][]byte{leaf.Raw}
+	for _, data := range pemData {
+		var certificates []*x509.Certificate
+		certificates, err = ParseCertificatesPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, certificate := range certificates {
+			if containsRaw(seen, certificate.Raw) {
+				continue
+			}
+			seen = append(seen, certificate.Raw)
+			issuers = append(issuers, certificate)
+		}
+	}
+	return
+}
+
+func containsRaw(seen [][]byte, raw []byte) bool {
+	for _, s := range seen {
+		if bytes.Equal(s, raw) {
+			return true
+		}
+	}
+	return false
+}
+
+func certificateChain(model interface{}) ([]*x509.Certificate, error) {
+	leaf, err := certificateLeaf(model)
+	if err != nil {
+		return nil, err
+	}
+	issuers, err := certificateIssuers(model, leaf)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*x509.Certificate{leaf}, issuers...), nil
+}
+
+func certificateCAPool(model interface{}) (*x509.CertPool, error) {
+	chain, err := certificateChain(model)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	for _, issuer := range chain[1:] {
+		pool.AddCert(issuer)
+	}
+	return pool, nil
+}
+
+// certificateTLS returns a tls.Certificate with the certificate of a model,
+// followed by its issuing certificates except for self-signed roots, which
+// are not sent in TLS handshakes. tls.X509KeyPair verifies that the private
+// key matches the certificate.
+func certificateTLS(model interface{}) (certificate tls.Certificate, err error) {
+	chain, err := certificateChain(model)
+	if err != nil {
+		return
+	}
+	var certificatePEM bytes.Buffer
+	for i, c := range chain {
+		if i > 0 && isSelfSigned(c) {
+			continue
+		}
+		_ = pem.Encode(&certificatePEM, &pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})
+	}
+	privateKey := modelStringField(model, "PrivateKey")
+	if privateKey == "" {
+		err = core.SDKErrorf(nil, "the secret has no private key; it must be retrieved with its payload", "private-key-not-found", common.GetComponentInfo())
+		return
+	}
+	certificate, err = tls.X509KeyPair(certificatePEM.Bytes(), []byte(privateKey))
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("the private key does not match the certificate: %s", err.Error()), "certificate-key-mismatch", common.GetComponentInfo())
+		return
+	}
+	certificate.Leaf = chain[0]
+	return
+}
+
+// certificateVerify verifies the certificate of a model with its issuing
+// certificates as intermediates. If "roots" is nil, the self-signed issuing
+// certificates are the roots, or the system roots are used if there are none.
+func certificateVerify(model interface{}, roots *x509.CertPool) (chains [][]*x509.Certificate, err error) {
+	chain, err := certificateChain(model)
+	if err != nil {
+		return
+	}
+	intermediates := x509.NewCertPool()
+	var selfSigned []*x509.Certificate
+	for _, issuer := range chain[1:] {
+		if isSelfSigned(issuer) {
+			selfSigned = append(selfSigned, issuer)
+		} else {
+			intermediates.AddCert(issuer)
+		}
+	}
+	if roots == nil && len(selfSigned) > 0 {
+		roots = x509.NewCertPool()
+		for _, root := range selfSigned {
+			roots.AddCert(root)
+		}
+	}
+	chains, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-verify-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// isSelfSigned returns whether a certificate is signed by its own key.
+func isSelfSigned(certificate *x509.Certificate) bool {
+	return bytes.Equal(certificate.RawIssuer, certificate.RawSubject) && certificate.CheckSignatureFrom(certificate) == nil
+}
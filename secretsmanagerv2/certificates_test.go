@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testCertificate is a certificate and its private key, in PEM form.
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     string
+	keyPEM      string
+}
+
+// newTestCertificate returns a certificate that is signed by "issuer", or a
+// self-signed certificate if "issuer" is nil.
+func newTestCertificate(commonName string, isCA bool, issuer *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		DNSNames:              []string{commonName},
+	}
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.certificate, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	Expect(err).To(BeNil())
+	certificate, err := x509.ParseCertificate(der)
+	Expect(err).To(BeNil())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).To(BeNil())
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:      string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+var _ = Describe(`Certificate helpers`, func() {
+	var root, intermediate, leaf, other *testCertificate
+
+	BeforeEach(func() {
+		root = newTestCertificate("root.example.com", true, nil)
+		intermediate = newTestCertificate("intermediate.example.com", true, root)
+		leaf = newTestCertificate("leaf.example.com", false, intermediate)
+		other = newTestCertificate("other.example.com", false, nil)
+	})
+
+	It(`Parse and verify a private certificate`, func() {
+		privateCertificate := &secretsmanagerv2.PrivateCertificate{
+			Certificate: core.StringPtr(leaf.certPEM),
+			PrivateKey:  core.StringPtr(leaf.keyPEM),
+			IssuingCa:   core.StringPtr(intermediate.certPEM),
+			CaChain:     []string{intermediate.certPEM, root.certPEM},
+		}
+
+		certificate, err := privateCertificate.X509Certificate()
+		Expect(err).To(BeNil())
+		Expect(certificate.Subject.CommonName).To(Equal("leaf.example.com"))
+
+		chain, err := privateCertificate.X509Chain()
+		Expect(err).To(BeNil())
+		Expect(chain).To(HaveLen(3))
+		Expect(chain[1].Equal(intermediate.certificate)).To(BeTrue())
+		Expect(chain[2].Equal(root.certificate)).To(BeTrue())
+
+		tlsCertificate, err := privateCertificate.TLSCertificate()
+		Expect(err).To(BeNil())
+		Expect(tlsCertificate.Certificate).To(HaveLen(2))
+		Expect(tlsCertificate.Leaf.Equal(leaf.certificate)).To(BeTrue())
+
+		pool, err := privateCertificate.CACertPool()
+		Expect(err).To(BeNil())
+		_, err = leaf.certificate.Verify(x509.VerifyOptions{Roots: pool})
+		Expect(err).To(BeNil())
+
+		chains, err := privateCertificate.VerifyChain(nil)
+		Expect(err).To(BeNil())
+		Expect(chains[0]).To(HaveLen(3))
+	})
+	It(`Verify a public certificate against explicit roots`, func() {
+		publicCertificate := &secretsmanagerv2.PublicCertificate{
+			Certificate:  core.StringPtr(leaf.certPEM),
+			Intermediate: core.StringPtr(intermediate.certPEM),
+			PrivateKey:   core.StringPtr(leaf.keyPEM),
+		}
+		roots := x509.NewCertPool()
+		roots.AddCert(root.certificate)
+
+		_, err := publicCertificate.VerifyChain(nil)
+		Expect(err).ToNot(BeNil())
+		chains, err := publicCertificate.VerifyChain(roots)
+		Expect(err).To(BeNil())
+		Expect(chains[0]).To(HaveLen(3))
+
+		_, err = publicCertificate.VerifyChain(x509.NewCertPool())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke TLSCertificate with error: the key does not match the certificate`, func() {
+		importedCertificate := &secretsmanagerv2.ImportedCertificate{
+			Certificate:  core.StringPtr(leaf.certPEM),
+			Intermediate: core.StringPtr(intermediate.certPEM),
+			PrivateKey:   core.StringPtr(other.keyPEM),
+		}
+		_, err := importedCertificate.TLSCertificate()
+		Expect(err).To(MatchError(ContainSubstring("the private key does not match the certificate")))
+
+		importedCertificate.PrivateKey = nil
+		_, err = importedCertificate.TLSCertificate()
+		Expect(err).To(MatchError(ContainSubstring("no private key")))
+	})
+	It(`Invoke VerifyChain with error: the chain does not verify`, func() {
+		importedCertificate := &secretsmanagerv2.ImportedCertificate{
+			Certificate:  core.StringPtr(leaf.certPEM),
+			Intermediate: core.StringPtr(other.certPEM),
+		}
+		_, err := importedCertificate.VerifyChain(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke X509Certificate with error: no payload`, func() {
+		_, err := (&secretsmanagerv2.ImportedCertificate{}).X509Certificate()
+		Expect(err).To(MatchError(ContainSubstring("no certificate")))
+
+		_, err = (&secretsmanagerv2.PublicCertificate{Certificate: core.StringPtr("-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n")}).X509Certificate()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Parse the certificates of a PEM string`, func() {
+		certificates, err := secretsmanagerv2.ParseCertificatesPEM(leaf.certPEM + leaf.keyPEM + intermediate.certPEM)
+		Expect(err).To(BeNil())
+		Expect(certificates).To(HaveLen(2))
+		Expect(certificates[1].Equal(intermediate.certificate)).To(BeTrue())
+	})
+})
@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// operationRoute is the HTTP method and the path template of the requests of
+// an operation.
+type operationRoute struct {
+	operation string
+	method    string
+	path      string
+}
+
+// requestOperation returns the name of the operation of a request, for
+// example "GetSecret", or an empty string if the request does not match the
+// route of an operation. The path of the request is matched from its end, so
+// that the path of the service URL does not matter.
+func requestOperation(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+	for _, route := range operationRoutes {
+		if route.method != req.Method {
+			continue
+		}
+		routeSegments := strings.Split(strings.Trim(route.path, "/"), "/")
+		if len(routeSegments) > len(segments) {
+			continue
+		}
+		matches := true
+		for i, routeSegment := range routeSegments {
+			segment := segments[len(segments)-len(routeSegments)+i]
+			if strings.HasPrefix(routeSegment, "{") {
+				matches = segment != ""
+			} else {
+				matches = segment == routeSegment
+			}
+			if !matches {
+				break
+			}
+		}
+		if matches {
+			return route.operation
+		}
+	}
+	return ""
+}
+
+// clientTransport is the http.RoundTripper of a client that is constructed
+// with request limits. It wraps the transport of the HTTP client of the
+// service, so that automatic retries go through it too.
+type clientTransport struct {
+	next   http.RoundTripper
+	limits *requestLimits
+}
+
+// installTransport wraps the transport of the HTTP client of "service" with a
+// clientTransport.
+func installTransport(service *core.BaseService, transport *clientTransport) {
+	client := *service.GetHTTPClient()
+	transport.next = client.Transport
+	if transport.next == nil {
+		// The wrapped transport is configured by disableSSLVerification, so it
+		// must not be shared.
+		transport.next = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	client.Transport = transport
+	service.SetHTTPClient(&client)
+}
+
+// RoundTrip sends a request within the limits of the client.
+func (transport *clientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return transport.limits.roundTrip(req, requestOperation(req), transport.next)
+}
+
+// disableSSLVerification skips the verification of the server certificates
+// by the wrapped transport, as core.BaseService.DisableSSLVerification does
+// for the transport of an HTTP client that is not wrapped.
+func (transport *clientTransport) disableSSLVerification() {
+	if next, ok := transport.next.(*http.Transport); ok {
+		if next.TLSClientConfig == nil {
+			next.TLSClientConfig = &tls.Config{} // #nosec G402
+		}
+		next.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
+	}
+}
+
+// isSSLDisabled returns true if the wrapped transport skips the verification
+// of the server certificates.
+func (transport *clientTransport) isSSLDisabled() bool {
+	next, ok := transport.next.(*http.Transport)
+	return ok && next.TLSClientConfig != nil && next.TLSClientConfig.InsecureSkipVerify
+}
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// CRNServiceName is the service name of the CRNs of Secrets Manager instances and secrets.
+const CRNServiceName = "secrets-manager"
+
+// CRN : A Cloud Resource Name, which identifies a Secrets Manager instance or a resource of an instance
+// It has the form "crn:<version>:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>",
+// for example "crn:v1:bluemix:public:secrets-manager:us-south:a/123:abc::" for an instance and
+// "crn:v1:bluemix:public:secrets-manager:us-south:a/123:abc:secret:def" for a secret.
+type CRN struct {
+	// The version of the CRN format, "v1".
+	Version string
+
+	// The cloud instance, for example "bluemix".
+	CName string
+
+	// The type of cloud, for example "public".
+	CType string
+
+	// The name of the service, CRNServiceName for Secrets Manager.
+	ServiceName string
+
+	// The location of the resource, which is the region of the instance.
+	Location string
+
+	// The scope of the resource, "a/<account ID>".
+	Scope string
+
+	// The GUID of the instance.
+	ServiceInstance string
+
+	// The type of the resource, for example "secret", or empty for the instance itself.
+	ResourceType string
+
+	// The ID of the resource, or empty for the instance itself.
+	Resource string
+}
+
+// ParseCRN parses the segments of a CRN. It checks the syntax of the CRN
+// only; use Validate to check that it identifies a Secrets Manager instance
+// or resource.
+func ParseCRN(crn string) (*CRN, error) {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the CRN '%s' is not valid; it must have the form 'crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>'", crn), "invalid-crn", common.GetComponentInfo())
+	}
+	return &CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}, nil
+}
+
+// String formats the CRN.
+func (crn *CRN) String() string {
+	return strings.Join([]string{
+		"crn", crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location,
+		crn.Scope, crn.ServiceInstance, crn.ResourceType, crn.Resource,
+	}, ":")
+}
+
+// Validate returns an error if the CRN does not identify a Secrets Manager
+// instance, or a resource of an instance, in a valid region.
+func (crn *CRN) Validate() error {
+	var problem string
+	switch {
+	case crn.Version != "v1":
+		problem = fmt.Sprintf("the version '%s' is not supported", crn.Version)
+	case crn.ServiceName != CRNServiceName:
+		problem = fmt.Sprintf("the service name '%s' is not '%s'", crn.ServiceName, CRNServiceName)
+	case !instanceIDPattern.MatchString(crn.ServiceInstance):
+		problem = fmt.Sprintf("the service instance '%s' is not valid", crn.ServiceInstance)
+	case crn.Scope != "" && !strings.HasPrefix(crn.Scope, "a/"):
+		problem = fmt.Sprintf("the scope '%s' is not an account", crn.Scope)
+	case (crn.ResourceType == "") != (crn.Resource == ""):
+		problem = "the resource type and the resource must be set together"
+	}
+	if problem != "" {
+		return core.SDKErrorf(nil, fmt.Sprintf("the CRN '%s' is not a Secrets Manager CRN: %s", crn.String(), problem), "invalid-crn", common.GetComponentInfo())
+	}
+	return validateRegion(crn.Location)
+}
+
+// IsInstance returns whether the CRN identifies an instance rather than a resource of an instance.
+func (crn *CRN) IsInstance() bool {
+	return crn.ResourceType == "" && crn.Resource == ""
+}
+
+// InstanceCRN returns the CRN of the instance of the resource.
+func (crn *CRN) InstanceCRN() *CRN {
+	instance := *crn
+	instance.ResourceType = ""
+	instance.Resource = ""
+	return &instance
+}
+
+// AccountID returns the ID of the account of the CRN, or an empty string if
+// its scope is not an account.
+func (crn *CRN) AccountID() string {
+	if accountID, ok := strings.CutPrefix(crn.Scope, "a/"); ok {
+		return accountID
+	}
+	return ""
+}
+
+// ServiceURL returns the URL of the endpoint of type "endpointType" of the
+// instance of the CRN. The empty endpoint type is the public endpoint.
+func (crn *CRN) ServiceURL(endpointType string) (string, error) {
+	if err := crn.Validate(); err != nil {
+		return "", err
+	}
+	return instanceServiceURL(crn.ServiceInstance, crn.Location, endpointType)
+}
+
+// NewSecretsManagerV2FromCRN : constructs an instance of SecretsManagerV2 for the Secrets Manager instance of a CRN.
+// "crn" is the CRN of the instance, or the CRN of a resource of the instance such as the "Crn" of a secret. The
+// service URL is the endpoint of type "options.EndpointType" of the instance; the URL, InstanceID and Region of
+// "options" are ignored.
+func NewSecretsManagerV2FromCRN(crn string, options *SecretsManagerV2Options) (service *SecretsManagerV2, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	parsed, err := ParseCRN(crn)
+	if err != nil {
+		return
+	}
+	serviceURL, err := parsed.ServiceURL(options.EndpointType)
+	if err != nil {
+		return
+	}
+
+	serviceOptions := *options
+	serviceOptions.URL = serviceURL
+	serviceOptions.InstanceID = ""
+	serviceOptions.Region = ""
+	service, err = NewSecretsManagerV2(&serviceOptions)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	return
+}
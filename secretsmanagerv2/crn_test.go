@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN`, func() {
+	const instanceCRN = "crn:v1:bluemix:public:secrets-manager:eu-gb:a/123456:a1b2c3d4-0000-1111-2222-333344445555::"
+	const secretCRN = "crn:v1:bluemix:public:secrets-manager:eu-gb:a/123456:a1b2c3d4-0000-1111-2222-333344445555:secret:b49ad24d-81d4-5ebc-b9b9-b0937d1c84d5"
+
+	It(`Parse, validate and format CRNs`, func() {
+		crn, err := secretsmanagerv2.ParseCRN(secretCRN)
+		Expect(err).To(BeNil())
+		Expect(crn.Validate()).To(Succeed())
+		Expect(crn.ServiceName).To(Equal(secretsmanagerv2.CRNServiceName))
+		Expect(crn.Location).To(Equal("eu-gb"))
+		Expect(crn.ServiceInstance).To(Equal("a1b2c3d4-0000-1111-2222-333344445555"))
+		Expect(crn.AccountID()).To(Equal("123456"))
+		Expect(crn.ResourceType).To(Equal("secret"))
+		Expect(crn.Resource).To(Equal("b49ad24d-81d4-5ebc-b9b9-b0937d1c84d5"))
+		Expect(crn.IsInstance()).To(BeFalse())
+		Expect(crn.String()).To(Equal(secretCRN))
+		Expect(crn.InstanceCRN().String()).To(Equal(instanceCRN))
+		Expect(crn.InstanceCRN().IsInstance()).To(BeTrue())
+
+		url, err := crn.ServiceURL(secretsmanagerv2.EndpointTypeDirect)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://a1b2c3d4-0000-1111-2222-333344445555.direct.eu-gb.secrets-manager.appdomain.cloud"))
+	})
+	It(`Invoke ParseCRN and Validate with error`, func() {
+		_, err := secretsmanagerv2.ParseCRN("a1b2c3d4-0000-1111-2222-333344445555")
+		Expect(err).To(MatchError(ContainSubstring("is not valid")))
+		_, err = secretsmanagerv2.ParseCRN("crn:v1:bluemix:public:secrets-manager:eu-gb:a/123456:abc")
+		Expect(err).ToNot(BeNil())
+
+		for _, invalid := range []string{
+			"crn:v2:bluemix:public:secrets-manager:eu-gb:a/123456:abc::",
+			"crn:v1:bluemix:public:kms:eu-gb:a/123456:abc::",
+			"crn:v1:bluemix:public:secrets-manager:eu-gb:a/123456:::",
+			"crn:v1:bluemix:public:secrets-manager:eu-gb:o/123456:abc::",
+			"crn:v1:bluemix:public:secrets-manager:eu-gb:a/123456:abc:secret:",
+			"crn:v1:bluemix:public:secrets-manager:global:a/123456:abc::",
+		} {
+			crn, err := secretsmanagerv2.ParseCRN(invalid)
+			Expect(err).To(BeNil())
+			Expect(crn.Validate()).ToNot(Succeed(), invalid)
+		}
+	})
+	It(`Construct a service from the CRN of a secret`, func() {
+		server := smtest.NewServer()
+		defer server.Close()
+		secretsManagerService, err := server.NewClient()
+		Expect(err).To(BeNil())
+		prototype, err := secretsManagerService.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+		Expect(err).To(BeNil())
+		secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+
+		crn, err := secretsmanagerv2.ParseCRN(*secret.(*secretsmanagerv2.ArbitrarySecret).Crn)
+		Expect(err).To(BeNil())
+		Expect(crn.Validate()).To(Succeed())
+		Expect(crn.ServiceInstance).To(Equal(smtest.InstanceID))
+		Expect(crn.Resource).To(Equal(*secret.(*secretsmanagerv2.ArbitrarySecret).ID))
+
+		crnService, err := secretsmanagerv2.NewSecretsManagerV2FromCRN(crn.String(), &secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			EndpointType:  secretsmanagerv2.EndpointTypePrivate,
+		})
+		Expect(err).To(BeNil())
+		Expect(crnService.Service.GetServiceURL()).To(Equal("https://" + smtest.InstanceID + ".private.us-south.secrets-manager.appdomain.cloud"))
+
+		_, err = secretsmanagerv2.NewSecretsManagerV2FromCRN(instanceCRN, nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsmanagerv2.NewSecretsManagerV2FromCRN("crn:v1:bluemix:public:kms:eu-gb:a/123456:abc::", &secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(MatchError(ContainSubstring("is not a Secrets Manager CRN")))
+	})
+})
@@ -0,0 +1,282 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultRouterUnhealthyPeriod is the time that an instance is skipped after
+// a failure when InstanceRouterOptions.UnhealthyPeriod is not set.
+const DefaultRouterUnhealthyPeriod = 30 * time.Second
+
+// RouterInstance : A Secrets Manager instance of an InstanceRouter.
+type RouterInstance struct {
+	// The name of the instance, which identifies it in RouterCall and RouterInstanceHealth, for example its region.
+	Name string `validate:"required"`
+
+	// The client of the instance.
+	Client SecretsManagerV2Intf `validate:"required"`
+}
+
+// InstanceRouterOptions : The options used to construct an InstanceRouter.
+type InstanceRouterOptions struct {
+	// The instances, from the primary instance to the last secondary instance.
+	Instances []RouterInstance `validate:"required,min=1,dive"`
+
+	// The time that an instance is skipped by reads after a failure. If it is zero, DefaultRouterUnhealthyPeriod is used.
+	UnhealthyPeriod time.Duration
+
+	// The timeout of each attempt of a read. A read that times out fails over to the next instance. If it is zero,
+	// only the timeouts of the clients and of the context apply.
+	AttemptTimeout time.Duration
+
+	// The function that is invoked after each call with the instance that served it.
+	OnCall func(call RouterCall)
+}
+
+// RouterCall : The outcome of a call of an InstanceRouter.
+type RouterCall struct {
+	// The name of the operation, for example "GetSecret".
+	Operation string
+
+	// The name of the instance that served the call, or that returned the error of the call.
+	Instance string
+
+	// The names of the instances that failed before the call was served, in order.
+	FailedOver []string
+
+	// The error of the call.
+	Err error
+}
+
+// RouterInstanceHealth : The health of an instance of an InstanceRouter.
+type RouterInstanceHealth struct {
+	// The name of the instance.
+	Name string
+
+	// Whether the instance is healthy: its last call did not fail, or it failed more than the unhealthy period ago.
+	Healthy bool
+
+	// The number of consecutive failures of the instance.
+	ConsecutiveFailures int
+
+	// The error of the last failure, or nil if the last call succeeded.
+	LastError error
+
+	// The time until which the instance is skipped by reads.
+	UnhealthyUntil time.Time
+}
+
+// InstanceRouter routes the operations of the Secrets Manager API to several
+// instances that hold replicas of the same secrets, for example in two
+// regions. It implements SecretsManagerV2Intf.
+//
+// Reads (the operations that use the GET method) are sent to the first
+// healthy instance in the order of InstanceRouterOptions.Instances. A read
+// fails over to the next instance when the response has a 5xx status code,
+// or when there is no response, for example because of a timeout or a
+// connection error; the failed instance is then skipped for the unhealthy
+// period. When every instance is unhealthy, they are all tried in order, so
+// that a read never fails only because of past failures.
+//
+// Writes are always sent to the primary instance, because the instances are
+// independent and a write to a secondary instance would not be replicated.
+type InstanceRouter struct {
+	instances       []*routerInstance
+	unhealthyPeriod time.Duration
+	attemptTimeout  time.Duration
+	onCall          func(call RouterCall)
+}
+
+type routerInstance struct {
+	name   string
+	client SecretsManagerV2Intf
+
+	mutex               sync.Mutex
+	consecutiveFailures int
+	lastError           error
+	unhealthyUntil      time.Time
+}
+
+var _ SecretsManagerV2Intf = (*InstanceRouter)(nil)
+
+// NewInstanceRouter returns a new InstanceRouter instance.
+func NewInstanceRouter(options *InstanceRouterOptions) (router *InstanceRouter, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if options.UnhealthyPeriod < 0 || options.AttemptTimeout < 0 {
+		err = core.SDKErrorf(nil, "the 'options.UnhealthyPeriod' and 'options.AttemptTimeout' fields must not be negative", "invalid-router-period", common.GetComponentInfo())
+		return
+	}
+
+	router = &InstanceRouter{
+		unhealthyPeriod: options.UnhealthyPeriod,
+		attemptTimeout:  options.AttemptTimeout,
+		onCall:          options.OnCall,
+	}
+	if router.unhealthyPeriod == 0 {
+		router.unhealthyPeriod = DefaultRouterUnhealthyPeriod
+	}
+	names := map[string]bool{}
+	for _, instance := range options.Instances {
+		if names[instance.Name] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the instance name '%s' is not unique", instance.Name), "duplicate-instance-name", common.GetComponentInfo())
+			return nil, err
+		}
+		names[instance.Name] = true
+		router.instances = append(router.instances, &routerInstance{name: instance.Name, client: instance.Client})
+	}
+	return
+}
+
+// Health returns the health of the instances, in the order of InstanceRouterOptions.Instances.
+func (router *InstanceRouter) Health() []RouterInstanceHealth {
+	now := time.Now()
+	health := make([]RouterInstanceHealth, 0, len(router.instances))
+	for _, instance := range router.instances {
+		instance.mutex.Lock()
+		health = append(health, RouterInstanceHealth{
+			Name:                instance.name,
+			Healthy:             !now.Before(instance.unhealthyUntil),
+			ConsecutiveFailures: instance.consecutiveFailures,
+			LastError:           instance.lastError,
+			UnhealthyUntil:      instance.unhealthyUntil,
+		})
+		instance.mutex.Unlock()
+	}
+	return health
+}
+
+// routerAttempt sends an operation to the client of an instance and returns the
+// response and the error of the operation.
+type routerAttempt func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error)
+
+// read sends a read to the healthy instances in order, then to the unhealthy
+// instances, until an instance serves it without a failure that warrants a
+// failover.
+func (router *InstanceRouter) read(ctx context.Context, operation string, attempt routerAttempt) (response *core.DetailedResponse, err error) {
+	now := time.Now()
+	var healthy, unhealthy []*routerInstance
+	for _, instance := range router.instances {
+		if instance.isHealthy(now) {
+			healthy = append(healthy, instance)
+		} else {
+			unhealthy = append(unhealthy, instance)
+		}
+	}
+
+	call := RouterCall{Operation: operation}
+	for _, instance := range append(healthy, unhealthy...) {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if router.attemptTimeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, router.attemptTimeout)
+		}
+		response, err = attempt(attemptCtx, instance.client)
+		cancel()
+
+		call.Instance = instance.name
+		if ctx.Err() != nil || !isFailoverError(response, err) {
+			if err == nil || response != nil {
+				instance.recordSuccess()
+			}
+			break
+		}
+		instance.recordFailure(err, router.unhealthyPeriod)
+		call.FailedOver = append(call.FailedOver, instance.name)
+	}
+	if err != nil && len(call.FailedOver) == len(router.instances) {
+		call.FailedOver = call.FailedOver[:len(call.FailedOver)-1]
+	}
+
+	call.Err = err
+	router.reportCall(call)
+	return
+}
+
+// write sends a write to the primary instance.
+func (router *InstanceRouter) write(ctx context.Context, operation string, attempt routerAttempt) (response *core.DetailedResponse, err error) {
+	primary := router.instances[0]
+	response, err = attempt(ctx, primary.client)
+	if isFailoverError(response, err) && ctx.Err() == nil {
+		primary.recordFailure(err, router.unhealthyPeriod)
+	} else if err == nil || response != nil {
+		primary.recordSuccess()
+	}
+	router.reportCall(RouterCall{Operation: operation, Instance: primary.name, Err: err})
+	return
+}
+
+func (router *InstanceRouter) reportCall(call RouterCall) {
+	if router.onCall != nil {
+		router.onCall(call)
+	}
+}
+
+// isFailoverError returns whether the outcome of an operation is a failure of
+// the instance rather than of the request: a 5xx status code, or a request
+// that could not be sent or timed out.
+func isFailoverError(response *core.DetailedResponse, err error) bool {
+	if err == nil {
+		return false
+	}
+	if response == nil {
+		// Requests that are not valid fail before they are sent, without a
+		// *url.Error.
+		var urlError *url.Error
+		return errors.As(err, &urlError)
+	}
+	return response.StatusCode >= 500
+}
+
+func (instance *routerInstance) isHealthy(now time.Time) bool {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	return !now.Before(instance.unhealthyUntil)
+}
+
+func (instance *routerInstance) recordSuccess() {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.consecutiveFailures = 0
+	instance.lastError = nil
+	instance.unhealthyUntil = time.Time{}
+}
+
+func (instance *routerInstance) recordFailure(err error, unhealthyPeriod time.Duration) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+	instance.consecutiveFailures++
+	instance.lastError = err
+	instance.unhealthyUntil = time.Now().Add(unhealthyPeriod)
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`InstanceRouter`, func() {
+	const (
+		replicaHealthy int32 = iota
+		replicaFailing
+		replicaSlow
+	)
+
+	type replica struct {
+		server  *smtest.Server
+		service *secretsmanagerv2.SecretsManagerV2
+		mode    atomic.Int32
+	}
+
+	var primary, secondary *replica
+	var router *secretsmanagerv2.InstanceRouter
+	var callsMutex sync.Mutex
+	var calls []secretsmanagerv2.RouterCall
+
+	newReplica := func(payload string) *replica {
+		r := &replica{server: smtest.NewUnstartedServer()}
+		r.server.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			switch r.mode.Load() {
+			case replicaFailing:
+				res.WriteHeader(http.StatusServiceUnavailable)
+				return
+			case replicaSlow:
+				time.Sleep(300 * time.Millisecond)
+			}
+			r.server.ServeHTTP(res, req)
+		})
+		r.server.Start()
+		var err error
+		r.service, err = r.server.NewClient()
+		Expect(err).To(BeNil())
+
+		prototype, err := r.service.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, payload)
+		Expect(err).To(BeNil())
+		_, _, err = r.service.CreateSecret(r.service.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+		return r
+	}
+	getPayload := func() (string, error) {
+		secret, _, err := router.GetSecretByNameType(primary.service.NewGetSecretByNameTypeOptions(secretsmanagerv2.Secret_SecretType_Arbitrary, "my-secret", "default"))
+		if err != nil {
+			return "", err
+		}
+		return *secret.(*secretsmanagerv2.ArbitrarySecret).Payload, nil
+	}
+	lastCall := func() secretsmanagerv2.RouterCall {
+		callsMutex.Lock()
+		defer callsMutex.Unlock()
+		return calls[len(calls)-1]
+	}
+
+	BeforeEach(func() {
+		primary = newReplica("from us-south")
+		secondary = newReplica("from us-east")
+		calls = nil
+
+		var err error
+		router, err = secretsmanagerv2.NewInstanceRouter(&secretsmanagerv2.InstanceRouterOptions{
+			Instances: []secretsmanagerv2.RouterInstance{
+				{Name: "us-south", Client: primary.service},
+				{Name: "us-east", Client: secondary.service},
+			},
+			UnhealthyPeriod: 200 * time.Millisecond,
+			AttemptTimeout:  100 * time.Millisecond,
+			OnCall: func(call secretsmanagerv2.RouterCall) {
+				callsMutex.Lock()
+				defer callsMutex.Unlock()
+				calls = append(calls, call)
+			},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		primary.server.Close()
+		secondary.server.Close()
+	})
+
+	It(`Send reads to the primary instance`, func() {
+		Expect(getPayload()).To(Equal("from us-south"))
+		Expect(lastCall()).To(Equal(secretsmanagerv2.RouterCall{Operation: "GetSecretByNameType", Instance: "us-south"}))
+		for _, health := range router.Health() {
+			Expect(health.Healthy).To(BeTrue())
+		}
+	})
+	It(`Fail over on 5xx responses and skip unhealthy instances`, func() {
+		primary.mode.Store(replicaFailing)
+		Expect(getPayload()).To(Equal("from us-east"))
+		Expect(lastCall().Instance).To(Equal("us-east"))
+		Expect(lastCall().FailedOver).To(Equal([]string{"us-south"}))
+
+		health := router.Health()
+		Expect(health[0].Name).To(Equal("us-south"))
+		Expect(health[0].Healthy).To(BeFalse())
+		Expect(health[0].ConsecutiveFailures).To(Equal(1))
+		Expect(health[0].LastError).ToNot(BeNil())
+		Expect(health[1].Healthy).To(BeTrue())
+
+		// The primary instance is skipped until the unhealthy period has elapsed.
+		primary.mode.Store(replicaHealthy)
+		Expect(getPayload()).To(Equal("from us-east"))
+		Expect(lastCall().FailedOver).To(BeEmpty())
+
+		Eventually(getPayload, time.Second, 20*time.Millisecond).Should(Equal("from us-south"))
+		Expect(router.Health()[0].Healthy).To(BeTrue())
+		Expect(router.Health()[0].ConsecutiveFailures).To(Equal(0))
+	})
+	It(`Fail over on timeouts`, func() {
+		primary.mode.Store(replicaSlow)
+		Expect(getPayload()).To(Equal("from us-east"))
+		Expect(lastCall().FailedOver).To(Equal([]string{"us-south"}))
+		Expect(router.Health()[0].Healthy).To(BeFalse())
+	})
+	It(`Try unhealthy instances when every instance is unhealthy`, func() {
+		primary.mode.Store(replicaFailing)
+		secondary.mode.Store(replicaFailing)
+		_, err := getPayload()
+		Expect(err).ToNot(BeNil())
+		Expect(lastCall().Instance).To(Equal("us-east"))
+		Expect(lastCall().FailedOver).To(Equal([]string{"us-south"}))
+		Expect(lastCall().Err).To(Equal(err))
+
+		secondary.mode.Store(replicaHealthy)
+		Expect(getPayload()).To(Equal("from us-east"))
+	})
+	It(`Do not fail over on client errors`, func() {
+		_, _, err := router.GetSecretByNameType(primary.service.NewGetSecretByNameTypeOptions(secretsmanagerv2.Secret_SecretType_Arbitrary, "missing", "default"))
+		Expect(err).ToNot(BeNil())
+		Expect(lastCall().Instance).To(Equal("us-south"))
+		Expect(lastCall().FailedOver).To(BeEmpty())
+		Expect(router.Health()[0].Healthy).To(BeTrue())
+
+		_, _, err = router.GetSecret(primary.service.NewGetSecretOptions(""))
+		Expect(err).ToNot(BeNil())
+		Expect(lastCall().FailedOver).To(BeEmpty())
+	})
+	It(`Send writes to the primary instance`, func() {
+		primary.mode.Store(replicaFailing)
+		_, err := getPayload()
+		Expect(err).To(BeNil())
+		primary.mode.Store(replicaHealthy)
+
+		prototype, err := primary.service.NewArbitrarySecretPrototype("my-other-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+		Expect(err).To(BeNil())
+		_, _, err = router.CreateSecret(primary.service.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+		Expect(lastCall()).To(Equal(secretsmanagerv2.RouterCall{Operation: "CreateSecret", Instance: "us-south"}))
+		Expect(router.Health()[0].Healthy).To(BeTrue())
+
+		_, _, err = secondary.service.GetSecretByNameType(secondary.service.NewGetSecretByNameTypeOptions(secretsmanagerv2.Secret_SecretType_Arbitrary, "my-other-secret", "default"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewInstanceRouter with error`, func() {
+		_, err := secretsmanagerv2.NewInstanceRouter(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsmanagerv2.NewInstanceRouter(&secretsmanagerv2.InstanceRouterOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = secretsmanagerv2.NewInstanceRouter(&secretsmanagerv2.InstanceRouterOptions{
+			Instances: []secretsmanagerv2.RouterInstance{
+				{Name: "us-south", Client: primary.service},
+				{Name: "us-south", Client: secondary.service},
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring("is not unique")))
+		_, err = secretsmanagerv2.NewInstanceRouter(&secretsmanagerv2.InstanceRouterOptions{
+			Instances:      []secretsmanagerv2.RouterInstance{{Name: "us-south", Client: primary.service}},
+			AttemptTimeout: -time.Second,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// RequestInvoker : A function that sends the request of an operation, and unmarshals the JSON body of the response
+// into "result".
+type RequestInvoker func(request *http.Request, result interface{}) (*core.DetailedResponse, error)
+
+// Interceptor : A function that intercepts the calls of the operations of a client.
+//
+// "operation" is the ID of the operation, for example "GetSecret", which is the ID that the operation passes to
+// common.GetSdkHeaders. "request" is the HTTP request of the call, which the interceptor can modify, for example to
+// add headers; its context is the context of the call. "result" is the value that the JSON body of the response is
+// unmarshaled into, or nil for the operations without a response body.
+//
+// The interceptor continues the call by calling "invoke", which calls the next interceptor or sends the request,
+// and it can modify the response and the error that it returns. It can also short-circuit the call by returning a
+// response or an error without calling "invoke"; it must then fill "result" itself for the operation to return a
+// result.
+type Interceptor func(operation string, request *http.Request, result interface{}, invoke RequestInvoker) (*core.DetailedResponse, error)
+
+// AddInterceptors adds interceptors to the end of the interceptor chain of
+// the client. The first interceptor of the chain is the outermost: it is
+// called first and returns last. Interceptors must be added before the client
+// is used concurrently; the interceptors of a clone are independent of the
+// interceptors of the client.
+func (secretsManager *SecretsManagerV2) AddInterceptors(interceptors ...Interceptor) {
+	secretsManager.interceptors = append(slices.Clip(secretsManager.interceptors), interceptors...)
+}
+
+// request sends the request of an operation through the interceptor chain.
+func (secretsManager *SecretsManagerV2) request(operation string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	invoke := RequestInvoker(secretsManager.Service.Request)
+	for i := len(secretsManager.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := secretsManager.interceptors[i], invoke
+		invoke = func(request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+			return interceptor(operation, request, result, next)
+		}
+	}
+
+	response, err = invoke(request, result)
+	if response == nil && err == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("an interceptor of the %s operation returned neither a response nor an error", operation), "interceptor-error", common.GetComponentInfo())
+	}
+	return
+}
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	var server *smtest.Server
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+	var requests atomic.Int64
+	var correlationIDs []string
+
+	BeforeEach(func() {
+		requests.Store(0)
+		correlationIDs = nil
+		server = smtest.NewUnstartedServer()
+		server.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			correlationIDs = append(correlationIDs, req.Header.Get("X-Correlation-Id"))
+			server.ServeHTTP(res, req)
+		})
+		server.Start()
+		var err error
+		secretsManagerService, err = server.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Call the interceptors in order with the operation ID`, func() {
+		var events []string
+		tracer := func(name string) secretsmanagerv2.Interceptor {
+			return func(operation string, request *http.Request, result interface{}, invoke secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+				events = append(events, name+" "+operation)
+				response, err := invoke(request, result)
+				events = append(events, name+" "+operation+" done")
+				return response, err
+			}
+		}
+		secretsManagerService.AddInterceptors(tracer("audit"), func(operation string, request *http.Request, result interface{}, invoke secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+			request.Header.Set("X-Correlation-Id", "my-correlation-id")
+			return invoke(request, result)
+		})
+		secretsManagerService.AddInterceptors(tracer("timer"))
+
+		prototype, err := secretsManagerService.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+		Expect(err).To(BeNil())
+		secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+		Expect(err).To(BeNil())
+		secretID := *secret.(*secretsmanagerv2.ArbitrarySecret).ID
+		_, err = secretsManagerService.DeleteSecret(secretsManagerService.NewDeleteSecretOptions(secretID))
+		Expect(err).To(BeNil())
+
+		Expect(events).To(Equal([]string{
+			"audit CreateSecret", "timer CreateSecret", "timer CreateSecret done", "audit CreateSecret done",
+			"audit DeleteSecret", "timer DeleteSecret", "timer DeleteSecret done", "audit DeleteSecret done",
+		}))
+		Expect(correlationIDs).To(Equal([]string{"my-correlation-id", "my-correlation-id"}))
+	})
+	It(`Short-circuit calls`, func() {
+		secretsManagerService.AddInterceptors(func(operation string, request *http.Request, result interface{}, invoke secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+			if operation != "GetSecretGroup" {
+				return invoke(request, result)
+			}
+			body := []byte(`{"id": "cached-group", "name": "cached-group", "created_at": "2026-01-01T00:00:00Z", "updated_at": "2026-01-01T00:00:00Z"}`)
+			if err := json.Unmarshal(body, result); err != nil {
+				return nil, err
+			}
+			return &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+		})
+
+		secretGroup, response, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions("default"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(*secretGroup.ID).To(Equal("cached-group"))
+		Expect(requests.Load()).To(BeZero())
+
+		_, _, err = secretsManagerService.ListSecretGroups(&secretsmanagerv2.ListSecretGroupsOptions{})
+		Expect(err).To(BeNil())
+		Expect(requests.Load()).To(Equal(int64(1)))
+	})
+	It(`Modify the errors of calls`, func() {
+		errNotFound := errors.New("not found")
+		secretsManagerService.AddInterceptors(func(operation string, request *http.Request, result interface{}, invoke secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+			response, err := invoke(request, result)
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				err = errNotFound
+			}
+			return response, err
+		})
+
+		_, response, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions("missing"))
+		Expect(errors.Is(err, errNotFound)).To(BeTrue())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+	})
+	It(`Invoke an interceptor with error: no response`, func() {
+		secretsManagerService.AddInterceptors(func(string, *http.Request, interface{}, secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+			return nil, nil
+		})
+		_, _, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions("default"))
+		Expect(err).To(MatchError(ContainSubstring("returned neither a response nor an error")))
+	})
+	It(`Keep the interceptors of clones independent`, func() {
+		var calls []string
+		recorder := func(name string) secretsmanagerv2.Interceptor {
+			return func(operation string, request *http.Request, result interface{}, invoke secretsmanagerv2.RequestInvoker) (*core.DetailedResponse, error) {
+				calls = append(calls, name)
+				return invoke(request, result)
+			}
+		}
+		secretsManagerService.AddInterceptors(recorder("client"))
+		clone := secretsManagerService.Clone()
+		clone.AddInterceptors(recorder("clone"))
+
+		_, _, err := secretsManagerService.ListSecretGroups(&secretsmanagerv2.ListSecretGroupsOptions{})
+		Expect(err).To(BeNil())
+		_, _, err = clone.ListSecretGroups(&secretsmanagerv2.ListSecretGroupsOptions{})
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"client", "client", "clone"}))
+	})
+})
@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// KVDecodeOptions : The options that control how a key-value payload is decoded into a struct.
+//
+// Keys are matched to struct fields with the same rules as encoding/json, so
+// fields are named by their `json` struct tag.
+type KVDecodeOptions struct {
+	// Fail if the payload contains a key that does not match a field of the target struct.
+	DisallowUnknownKeys bool
+
+	// Fail if a field of the target struct has no key in the payload. Fields whose `json`
+	// tag has the "omitempty" option are optional.
+	DisallowMissingKeys bool
+}
+
+// DecodeData decodes the payload of the key-value secret into "target", which
+// must be a pointer to a struct.
+func (kvSecret *KVSecret) DecodeData(target interface{}, options *KVDecodeOptions) error {
+	return DecodeKVData(kvSecret.Data, target, options)
+}
+
+// DecodeData decodes the payload of the key-value secret version into
+// "target", which must be a pointer to a struct.
+func (kvSecretVersion *KVSecretVersion) DecodeData(target interface{}, options *KVDecodeOptions) error {
+	return DecodeKVData(kvSecretVersion.Data, target, options)
+}
+
+// DecodeCredentialsContent decodes the credentials of the custom credentials
+// secret into "target", which must be a pointer to a struct.
+func (customCredentialsSecret *CustomCredentialsSecret) DecodeCredentialsContent(target interface{}, options *KVDecodeOptions) error {
+	return DecodeKVData(customCredentialsSecret.CredentialsContent, target, options)
+}
+
+// DecodeCredentialsContent decodes the credentials of the custom credentials
+// secret version into "target", which must be a pointer to a struct.
+func (customCredentialsSecretVersion *CustomCredentialsSecretVersion) DecodeCredentialsContent(target interface{}, options *KVDecodeOptions) error {
+	return DecodeKVData(customCredentialsSecretVersion.CredentialsContent, target, options)
+}
+
+// DecodeKVData decodes a key-value payload into "target", which must be a
+// pointer to a struct.
+func DecodeKVData(data map[string]interface{}, target interface{}, options *KVDecodeOptions) (err error) {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Struct {
+		err = core.SDKErrorf(nil, "the target must be a non-nil pointer to a struct", "invalid-decode-target", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = &KVDecodeOptions{}
+	}
+
+	if options.DisallowMissingKeys {
+		var missing []string
+		for _, field := range kvStructFields(targetValue.Elem().Type()) {
+			if !field.optional && !kvDataHasKey(data, field.name) {
+				missing = append(missing, field.name)
+			}
+		}
+		if len(missing) > 0 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the payload is missing the keys: %s", strings.Join(missing, ", ")), "missing-kv-keys", common.GetComponentInfo())
+			return
+		}
+	}
+
+	buffer, err := json.Marshal(data)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "kv-marshal-error", common.GetComponentInfo())
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
+	if options.DisallowUnknownKeys {
+		decoder.DisallowUnknownFields()
+	}
+	err = decoder.Decode(target)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "kv-decode-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// EncodeKVData converts "source", a struct or a pointer to a struct, into a
+// key-value payload.
+func EncodeKVData(source interface{}) (data map[string]interface{}, err error) {
+	sourceValue := reflect.ValueOf(source)
+	if sourceValue.Kind() == reflect.Ptr && !sourceValue.IsNil() {
+		sourceValue = sourceValue.Elem()
+	}
+	if sourceValue.Kind() != reflect.Struct {
+		err = core.SDKErrorf(nil, "the source must be a struct or a non-nil pointer to a struct", "invalid-encode-source", common.GetComponentInfo())
+		return
+	}
+
+	buffer, err := json.Marshal(source)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "kv-marshal-error", common.GetComponentInfo())
+		return
+	}
+	err = json.Unmarshal(buffer, &data)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "kv-unmarshal-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// NewKVSecretPrototypeFromStruct : Instantiate KVSecretPrototype with a payload that is encoded from "source"
+func (secretsManager *SecretsManagerV2) NewKVSecretPrototypeFromStruct(name string, source interface{}) (_model *KVSecretPrototype, err error) {
+	data, err := EncodeKVData(source)
+	if err != nil {
+		return
+	}
+	_model, err = secretsManager.NewKVSecretPrototype(KVSecretPrototype_SecretType_Kv, name, data)
+	return
+}
+
+// NewKVSecretVersionPrototypeFromStruct : Instantiate KVSecretVersionPrototype with a payload that is encoded from "source"
+func (secretsManager *SecretsManagerV2) NewKVSecretVersionPrototypeFromStruct(source interface{}) (_model *KVSecretVersionPrototype, err error) {
+	data, err := EncodeKVData(source)
+	if err != nil {
+		return
+	}
+	_model, err = secretsManager.NewKVSecretVersionPrototype(data)
+	return
+}
+
+type kvStructField struct {
+	name     string
+	optional bool
+}
+
+// kvStructFields returns the payload keys of the exported fields of a struct
+// type, following the naming rules of encoding/json. Fields of embedded
+// structs without a `json` tag are promoted.
+func kvStructFields(structType reflect.Type) (fields []kvStructField) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, tagOptions, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, kvStructFields(fieldType)...)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, kvStructField{
+			name:     name,
+			optional: strings.Contains(","+tagOptions+",", ",omitempty,"),
+		})
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].name < fields[j].name
+	})
+	return
+}
+
+// kvDataHasKey reports whether "data" has a key that encoding/json would
+// match to a field named "name".
+func kvDataHasKey(data map[string]interface{}, name string) bool {
+	if _, ok := data[name]; ok {
+		return true
+	}
+	for key := range data {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type databaseCredentials struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Password string `json:"password"`
+	Options  string `json:"options,omitempty"`
+}
+
+var _ = Describe(`Key-value payload decoding`, func() {
+	secretsManagerService, _ := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+		URL:           "http://secretsmanagerv2modelgenerator.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+
+	It(`Invoke KVSecret.DecodeData successfully`, func() {
+		kvSecret := &secretsmanagerv2.KVSecret{
+			Data: map[string]interface{}{"host": "db.example.com", "port": float64(5432), "password": "my-password", "extra": true},
+		}
+
+		var credentials databaseCredentials
+		err := kvSecret.DecodeData(&credentials, nil)
+		Expect(err).To(BeNil())
+		Expect(credentials).To(Equal(databaseCredentials{Host: "db.example.com", Port: 5432, Password: "my-password"}))
+	})
+	It(`Invoke DecodeKVData with error: strict mode`, func() {
+		data := map[string]interface{}{"host": "db.example.com", "port": float64(5432), "extra": true}
+
+		var credentials databaseCredentials
+		err := secretsmanagerv2.DecodeKVData(data, &credentials, &secretsmanagerv2.KVDecodeOptions{DisallowUnknownKeys: true})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("extra"))
+
+		err = secretsmanagerv2.DecodeKVData(data, &credentials, &secretsmanagerv2.KVDecodeOptions{DisallowMissingKeys: true})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("password"))
+		Expect(err.Error()).ToNot(ContainSubstring("options"))
+	})
+	It(`Invoke DecodeKVData with error: invalid target`, func() {
+		var credentials databaseCredentials
+		err := secretsmanagerv2.DecodeKVData(map[string]interface{}{}, credentials, nil)
+		Expect(err).ToNot(BeNil())
+
+		err = secretsmanagerv2.DecodeKVData(map[string]interface{}{"port": "not-a-number"}, &credentials, nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke CustomCredentialsSecret.DecodeCredentialsContent successfully`, func() {
+		customCredentialsSecret := &secretsmanagerv2.CustomCredentialsSecret{
+			CredentialsContent: map[string]interface{}{"host": "db.example.com", "port": float64(5432), "password": "my-password"},
+		}
+
+		var credentials databaseCredentials
+		err := customCredentialsSecret.DecodeCredentialsContent(&credentials, &secretsmanagerv2.KVDecodeOptions{DisallowUnknownKeys: true, DisallowMissingKeys: true})
+		Expect(err).To(BeNil())
+		Expect(credentials.Password).To(Equal("my-password"))
+	})
+	It(`Invoke NewKVSecretPrototypeFromStruct successfully`, func() {
+		credentials := databaseCredentials{Host: "db.example.com", Port: 5432, Password: "my-password"}
+
+		kvSecretPrototypeModel, err := secretsManagerService.NewKVSecretPrototypeFromStruct("my-secret", &credentials)
+		Expect(err).To(BeNil())
+		Expect(*kvSecretPrototypeModel.SecretType).To(Equal("kv"))
+		Expect(*kvSecretPrototypeModel.Name).To(Equal("my-secret"))
+		Expect(kvSecretPrototypeModel.Data).To(Equal(map[string]interface{}{"host": "db.example.com", "port": float64(5432), "password": "my-password"}))
+
+		kvSecretVersionPrototypeModel, err := secretsManagerService.NewKVSecretVersionPrototypeFromStruct(credentials)
+		Expect(err).To(BeNil())
+		Expect(kvSecretVersionPrototypeModel.Data).To(Equal(kvSecretPrototypeModel.Data))
+
+		_, err = secretsManagerService.NewKVSecretVersionPrototypeFromStruct("not-a-struct")
+		Expect(err).ToNot(BeNil())
+	})
+})
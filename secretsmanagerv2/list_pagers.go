@@ -0,0 +1,415 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// Pager is the interface that is implemented by the pagers of all list
+// operations, so that generic tooling can consume any listing.
+//
+// The "ListSecretGroups", "ListSecretVersions" and "ListSecretTasks" operations
+// return all of their results in a single response; their pagers deliver that
+// response as a single page.
+type Pager[T any] interface {
+	// HasNext returns true if there are potentially more results to be retrieved.
+	HasNext() bool
+
+	// GetNext returns the next page of results.
+	GetNext() ([]T, error)
+
+	// GetNextWithContext returns the next page of results using the specified Context.
+	GetNextWithContext(ctx context.Context) ([]T, error)
+
+	// GetAll returns all remaining results.
+	GetAll() ([]T, error)
+
+	// GetAllWithContext returns all remaining results using the specified Context.
+	GetAllWithContext(ctx context.Context) ([]T, error)
+
+	// Iterate returns an iterator over the remaining results.
+	Iterate(ctx context.Context) iter.Seq2[T, error]
+
+	// Stream delivers the remaining results on a channel.
+	Stream(ctx context.Context) <-chan PagerItem[T]
+
+	// Cursor returns an opaque cursor that records the options and position of the pager.
+	Cursor() (string, error)
+}
+
+var (
+	_ Pager[SecretMetadataIntf]        = (*SecretsPager)(nil)
+	_ Pager[SecretLocks]               = (*SecretsLocksPager)(nil)
+	_ Pager[SecretLock]                = (*SecretLocksPager)(nil)
+	_ Pager[SecretLock]                = (*SecretVersionLocksPager)(nil)
+	_ Pager[ConfigurationMetadataIntf] = (*ConfigurationsPager)(nil)
+	_ Pager[SecretGroup]               = (*SecretGroupsPager)(nil)
+	_ Pager[SecretVersionMetadataIntf] = (*SecretVersionsPager)(nil)
+	_ Pager[SecretTask]                = (*SecretTasksPager)(nil)
+)
+
+// SecretGroupsPager can be used to simplify the use of the "ListSecretGroups" method.
+type SecretGroupsPager struct {
+	hasNext bool
+	options *ListSecretGroupsOptions
+	client  *SecretsManagerV2
+}
+
+// NewSecretGroupsPager returns a new SecretGroupsPager instance.
+func (secretsManager *SecretsManagerV2) NewSecretGroupsPager(options *ListSecretGroupsOptions) (pager *SecretGroupsPager, err error) {
+	var optionsCopy ListSecretGroupsOptions
+	if options != nil {
+		optionsCopy = *options
+	}
+	pager = &SecretGroupsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  secretsManager,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SecretGroupsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SecretGroupsPager) GetNextWithContext(ctx context.Context) (page []SecretGroup, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListSecretGroupsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.hasNext = false
+	if result != nil {
+		page = result.SecretGroups
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SecretGroupsPager) GetAllWithContext(ctx context.Context) (allItems []SecretGroup, err error) {
+	for pager.HasNext() {
+		var nextPage []SecretGroup
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SecretGroupsPager) GetNext() (page []SecretGroup, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SecretGroupsPager) GetAll() (allItems []SecretGroup, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Iterate returns an iterator over the remaining results of the pager.
+func (pager *SecretGroupsPager) Iterate(ctx context.Context) iter.Seq2[SecretGroup, error] {
+	return iteratePages[SecretGroup](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretGroupsPager) Stream(ctx context.Context) <-chan PagerItem[SecretGroup] {
+	return streamPages[SecretGroup](ctx, pager)
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretGroupsPager to continue the listing.
+func (pager *SecretGroupsPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secret_groups", &optionsCopy, nil, pager.hasNext)
+	return
+}
+
+// ResumeSecretGroupsPager returns a SecretGroupsPager instance that continues
+// from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretGroupsPager(cursor string) (pager *SecretGroupsPager, err error) {
+	options := new(ListSecretGroupsOptions)
+	_, hasNext, err := decodePagerCursor("secret_groups", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretGroupsPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	return
+}
+
+// SecretVersionsPager can be used to simplify the use of the "ListSecretVersions" method.
+type SecretVersionsPager struct {
+	hasNext bool
+	options *ListSecretVersionsOptions
+	client  *SecretsManagerV2
+}
+
+// NewSecretVersionsPager returns a new SecretVersionsPager instance.
+func (secretsManager *SecretsManagerV2) NewSecretVersionsPager(options *ListSecretVersionsOptions) (pager *SecretVersionsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListSecretVersionsOptions = *options
+	pager = &SecretVersionsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  secretsManager,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SecretVersionsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SecretVersionsPager) GetNextWithContext(ctx context.Context) (page []SecretVersionMetadataIntf, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListSecretVersionsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.hasNext = false
+	if result != nil {
+		page = result.Versions
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SecretVersionsPager) GetAllWithContext(ctx context.Context) (allItems []SecretVersionMetadataIntf, err error) {
+	for pager.HasNext() {
+		var nextPage []SecretVersionMetadataIntf
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SecretVersionsPager) GetNext() (page []SecretVersionMetadataIntf, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SecretVersionsPager) GetAll() (allItems []SecretVersionMetadataIntf, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Iterate returns an iterator over the remaining results of the pager.
+func (pager *SecretVersionsPager) Iterate(ctx context.Context) iter.Seq2[SecretVersionMetadataIntf, error] {
+	return iteratePages[SecretVersionMetadataIntf](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretVersionsPager) Stream(ctx context.Context) <-chan PagerItem[SecretVersionMetadataIntf] {
+	return streamPages[SecretVersionMetadataIntf](ctx, pager)
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretVersionsPager to continue the listing.
+func (pager *SecretVersionsPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secret_versions", &optionsCopy, nil, pager.hasNext)
+	return
+}
+
+// ResumeSecretVersionsPager returns a SecretVersionsPager instance that
+// continues from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretVersionsPager(cursor string) (pager *SecretVersionsPager, err error) {
+	options := new(ListSecretVersionsOptions)
+	_, hasNext, err := decodePagerCursor("secret_versions", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretVersionsPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	return
+}
+
+// SecretTasksPager can be used to simplify the use of the "ListSecretTasks" method.
+type SecretTasksPager struct {
+	hasNext bool
+	options *ListSecretTasksOptions
+	client  *SecretsManagerV2
+}
+
+// NewSecretTasksPager returns a new SecretTasksPager instance.
+func (secretsManager *SecretsManagerV2) NewSecretTasksPager(options *ListSecretTasksOptions) (pager *SecretTasksPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListSecretTasksOptions = *options
+	pager = &SecretTasksPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  secretsManager,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SecretTasksPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SecretTasksPager) GetNextWithContext(ctx context.Context) (page []SecretTask, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListSecretTasksWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.hasNext = false
+	if result != nil {
+		page = result.Tasks
+	}
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SecretTasksPager) GetAllWithContext(ctx context.Context) (allItems []SecretTask, err error) {
+	for pager.HasNext() {
+		var nextPage []SecretTask
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SecretTasksPager) GetNext() (page []SecretTask, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SecretTasksPager) GetAll() (allItems []SecretTask, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Iterate returns an iterator over the remaining results of the pager.
+func (pager *SecretTasksPager) Iterate(ctx context.Context) iter.Seq2[SecretTask, error] {
+	return iteratePages[SecretTask](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretTasksPager) Stream(ctx context.Context) <-chan PagerItem[SecretTask] {
+	return streamPages[SecretTask](ctx, pager)
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretTasksPager to continue the listing.
+func (pager *SecretTasksPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secret_tasks", &optionsCopy, nil, pager.hasNext)
+	return
+}
+
+// ResumeSecretTasksPager returns a SecretTasksPager instance that continues
+// from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretTasksPager(cursor string) (pager *SecretTasksPager, err error) {
+	options := new(ListSecretTasksOptions)
+	_, hasNext, err := decodePagerCursor("secret_tasks", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretTasksPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	return
+}
@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// countPagerItems consumes any pager through the Pager interface.
+func countPagerItems[T any](pager secretsmanagerv2.Pager[T]) (count int, err error) {
+	for _, err = range pager.Iterate(context.Background()) {
+		if err != nil {
+			return
+		}
+		count++
+	}
+	return
+}
+
+var _ = Describe(`Pagers for single-page list operations`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+
+	BeforeEach(func() {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			requestCount++
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/api/v2/secret_groups":
+				fmt.Fprintf(res, "%s", `{"secret_groups": [{"id": "default", "name": "default"}, {"id": "d898bb90-82f6-4d61-b5cc-b079b66cfa76", "name": "my-secret-group"}], "total_count": 2}`)
+			case "/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46/versions":
+				fmt.Fprintf(res, "%s", `{"versions": [{"id": "version-2", "alias": "current", "secret_type": "arbitrary"}, {"id": "version-1", "alias": "previous", "secret_type": "arbitrary"}], "total_count": 2}`)
+			case "/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46/tasks":
+				fmt.Fprintf(res, "%s", `{"tasks": [{"id": "task-1", "type": "create_credentials", "status": "queued"}]}`)
+			default:
+				Fail("unexpected request path " + req.URL.EscapedPath())
+			}
+		}))
+		var serviceErr error
+		secretsManagerService, serviceErr = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Use SecretGroupsPager.GetNext successfully`, func() {
+		pager, err := secretsManagerService.NewSecretGroupsPager(&secretsmanagerv2.ListSecretGroupsOptions{})
+		Expect(err).To(BeNil())
+		Expect(pager.HasNext()).To(BeTrue())
+
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(page).To(HaveLen(2))
+		Expect(*page[1].Name).To(Equal("my-secret-group"))
+		Expect(pager.HasNext()).To(BeFalse())
+
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+		Expect(requestCount).To(Equal(1))
+	})
+	It(`Use SecretVersionsPager.GetAll successfully`, func() {
+		pager, err := secretsManagerService.NewSecretVersionsPager(&secretsmanagerv2.ListSecretVersionsOptions{
+			SecretID: core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"),
+		})
+		Expect(err).To(BeNil())
+
+		allResults, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(allResults).To(HaveLen(2))
+	})
+	It(`Consume every pager through the Pager interface`, func() {
+		secretGroupsPager, err := secretsManagerService.NewSecretGroupsPager(nil)
+		Expect(err).To(BeNil())
+		Expect(countPagerItems[secretsmanagerv2.SecretGroup](secretGroupsPager)).To(Equal(2))
+
+		secretVersionsPager, err := secretsManagerService.NewSecretVersionsPager(&secretsmanagerv2.ListSecretVersionsOptions{
+			SecretID: core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"),
+		})
+		Expect(err).To(BeNil())
+		Expect(countPagerItems[secretsmanagerv2.SecretVersionMetadataIntf](secretVersionsPager)).To(Equal(2))
+
+		secretTasksPager, err := secretsManagerService.NewSecretTasksPager(&secretsmanagerv2.ListSecretTasksOptions{
+			SecretID: core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"),
+		})
+		Expect(err).To(BeNil())
+		Expect(countPagerItems[secretsmanagerv2.SecretTask](secretTasksPager)).To(Equal(1))
+	})
+	It(`Resume a SecretTasksPager from a cursor`, func() {
+		pager, err := secretsManagerService.NewSecretTasksPager(&secretsmanagerv2.ListSecretTasksOptions{
+			SecretID: core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"),
+		})
+		Expect(err).To(BeNil())
+		cursor, err := pager.Cursor()
+		Expect(err).To(BeNil())
+
+		resumedPager, err := secretsManagerService.ResumeSecretTasksPager(cursor)
+		Expect(err).To(BeNil())
+		allResults, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(*allResults[0].ID).To(Equal("task-1"))
+	})
+	It(`Invoke NewSecretVersionsPager and NewSecretTasksPager with error: invalid options`, func() {
+		_, err := secretsManagerService.NewSecretVersionsPager(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.NewSecretVersionsPager(&secretsmanagerv2.ListSecretVersionsOptions{})
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.NewSecretTasksPager(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = secretsManagerService.NewSecretTasksPager(&secretsmanagerv2.ListSecretTasksOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
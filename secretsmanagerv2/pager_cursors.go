@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// A pager cursor is an opaque string that records the list options and the
+// next offset of a pager, so that a long listing can be checkpointed and
+// resumed later, possibly by another process. Custom request headers are not
+// recorded in a cursor.
+
+// pagerCursorVersion is the version of the cursor format.
+const pagerCursorVersion = 1
+
+type pagerCursor struct {
+	Version int             `json:"v"`
+	Pager   string          `json:"pager"`
+	Options json.RawMessage `json:"options"`
+	Next    *int64          `json:"next,omitempty"`
+	HasNext bool            `json:"has_next"`
+}
+
+// encodePagerCursor serializes the state of a pager of the specified kind.
+func encodePagerCursor(kind string, options interface{}, next *int64, hasNext bool) (cursor string, err error) {
+	rawOptions, err := json.Marshal(options)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cursor-marshal-error", common.GetComponentInfo())
+		return
+	}
+	buffer, err := json.Marshal(&pagerCursor{
+		Version: pagerCursorVersion,
+		Pager:   kind,
+		Options: rawOptions,
+		Next:    next,
+		HasNext: hasNext,
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cursor-marshal-error", common.GetComponentInfo())
+		return
+	}
+	cursor = base64.RawURLEncoding.EncodeToString(buffer)
+	return
+}
+
+// decodePagerCursor restores the state of a pager of the specified kind into
+// "options" and returns the next offset of the pager.
+func decodePagerCursor(kind string, cursor string, options interface{}) (next *int64, hasNext bool, err error) {
+	buffer, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		err = core.SDKErrorf(err, "the pager cursor is malformed", "invalid-cursor", common.GetComponentInfo())
+		return
+	}
+	decoded := new(pagerCursor)
+	err = json.Unmarshal(buffer, decoded)
+	if err != nil {
+		err = core.SDKErrorf(err, "the pager cursor is malformed", "invalid-cursor", common.GetComponentInfo())
+		return
+	}
+	if decoded.Version != pagerCursorVersion {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported pager cursor version %d", decoded.Version), "invalid-cursor-version", common.GetComponentInfo())
+		return
+	}
+	if decoded.Pager != kind {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the pager cursor belongs to a '%s' pager, not a '%s' pager", decoded.Pager, kind), "invalid-cursor-pager", common.GetComponentInfo())
+		return
+	}
+	err = json.Unmarshal(decoded.Options, options)
+	if err != nil {
+		err = core.SDKErrorf(err, "the pager cursor is malformed", "invalid-cursor", common.GetComponentInfo())
+		return
+	}
+	next = decoded.Next
+	hasNext = decoded.HasNext
+	return
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretsPager to continue the listing.
+func (pager *SecretsPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Offset = nil
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secrets", &optionsCopy, pager.pageContext.next, pager.hasNext)
+	return
+}
+
+// ResumeSecretsPager returns a SecretsPager instance that continues from the
+// position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretsPager(cursor string) (pager *SecretsPager, err error) {
+	options := new(ListSecretsOptions)
+	next, hasNext, err := decodePagerCursor("secrets", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretsPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretsLocksPager to continue the listing.
+func (pager *SecretsLocksPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Offset = nil
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secrets_locks", &optionsCopy, pager.pageContext.next, pager.hasNext)
+	return
+}
+
+// ResumeSecretsLocksPager returns a SecretsLocksPager instance that continues
+// from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretsLocksPager(cursor string) (pager *SecretsLocksPager, err error) {
+	options := new(ListSecretsLocksOptions)
+	next, hasNext, err := decodePagerCursor("secrets_locks", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretsLocksPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretLocksPager to continue the listing.
+func (pager *SecretLocksPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Offset = nil
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secret_locks", &optionsCopy, pager.pageContext.next, pager.hasNext)
+	return
+}
+
+// ResumeSecretLocksPager returns a SecretLocksPager instance that continues
+// from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretLocksPager(cursor string) (pager *SecretLocksPager, err error) {
+	options := new(ListSecretLocksOptions)
+	next, hasNext, err := decodePagerCursor("secret_locks", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretLocksPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeSecretVersionLocksPager to continue the listing.
+func (pager *SecretVersionLocksPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Offset = nil
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("secret_version_locks", &optionsCopy, pager.pageContext.next, pager.hasNext)
+	return
+}
+
+// ResumeSecretVersionLocksPager returns a SecretVersionLocksPager instance that
+// continues from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeSecretVersionLocksPager(cursor string) (pager *SecretVersionLocksPager, err error) {
+	options := new(ListSecretVersionLocksOptions)
+	next, hasNext, err := decodePagerCursor("secret_version_locks", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &SecretVersionLocksPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	pager.pageContext.next = next
+	return
+}
+
+// Cursor returns an opaque cursor that records the options and position of the
+// pager. Pass it to ResumeConfigurationsPager to continue the listing.
+func (pager *ConfigurationsPager) Cursor() (cursor string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Offset = nil
+	optionsCopy.Headers = nil
+	cursor, err = encodePagerCursor("configurations", &optionsCopy, pager.pageContext.next, pager.hasNext)
+	return
+}
+
+// ResumeConfigurationsPager returns a ConfigurationsPager instance that
+// continues from the position recorded in "cursor".
+func (secretsManager *SecretsManagerV2) ResumeConfigurationsPager(cursor string) (pager *ConfigurationsPager, err error) {
+	options := new(ListConfigurationsOptions)
+	next, hasNext, err := decodePagerCursor("configurations", cursor, options)
+	if err != nil {
+		return
+	}
+	pager = &ConfigurationsPager{
+		hasNext: hasNext,
+		options: options,
+		client:  secretsManager,
+	}
+	pager.pageContext.next = next
+	return
+}
@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pager cursors`, func() {
+	var testServer *httptest.Server
+	var requestCount atomic.Int64
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+
+	BeforeEach(func() {
+		requestCount.Store(0)
+		testServer = httptest.NewServer(newListSecretsHandler(5, &requestCount))
+		var serviceErr error
+		secretsManagerService, serviceErr = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Resume a SecretsPager from a cursor`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit:       core.Int64Ptr(int64(2)),
+			SecretTypes: []string{"arbitrary"},
+			Headers:     map[string]string{"x-custom-header": "x-custom-value"},
+		})
+		Expect(err).To(BeNil())
+
+		firstPage, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(firstPage).To(HaveLen(2))
+
+		cursor, err := pager.Cursor()
+		Expect(err).To(BeNil())
+
+		resumedPager, err := secretsManagerService.ResumeSecretsPager(cursor)
+		Expect(err).To(BeNil())
+		Expect(resumedPager.HasNext()).To(BeTrue())
+
+		remaining, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(remaining).To(HaveLen(3))
+		Expect(*remaining[0].(*secretsmanagerv2.ArbitrarySecretMetadata).Name).To(Equal("secret-2"))
+		Expect(requestCount.Load()).To(Equal(int64(3)))
+
+		cursor, err = resumedPager.Cursor()
+		Expect(err).To(BeNil())
+		finishedPager, err := secretsManagerService.ResumeSecretsPager(cursor)
+		Expect(err).To(BeNil())
+		Expect(finishedPager.HasNext()).To(BeFalse())
+	})
+	It(`Resume a SecretsPager from the cursor of a new pager`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		cursor, err := pager.Cursor()
+		Expect(err).To(BeNil())
+		resumedPager, err := secretsManagerService.ResumeSecretsPager(cursor)
+		Expect(err).To(BeNil())
+
+		allResults, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(allResults).To(HaveLen(5))
+	})
+	It(`Invoke ResumeSecretsPager with error: invalid cursor`, func() {
+		pager, err := secretsManagerService.ResumeSecretsPager("not a cursor")
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+
+		configurationsPager, err := secretsManagerService.NewConfigurationsPager(&secretsmanagerv2.ListConfigurationsOptions{})
+		Expect(err).To(BeNil())
+		cursor, err := configurationsPager.Cursor()
+		Expect(err).To(BeNil())
+
+		pager, err = secretsManagerService.ResumeSecretsPager(cursor)
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+
+		resumedConfigurationsPager, err := secretsManagerService.ResumeConfigurationsPager(cursor)
+		Expect(err).To(BeNil())
+		Expect(resumedConfigurationsPager.HasNext()).To(BeTrue())
+	})
+})
@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PagerItem : A single result, or the error that ended the iteration, that is delivered by a pager stream.
+type PagerItem[T any] struct {
+	// The result. It is the zero value if Err is set.
+	Item T
+
+	// The error that ended the iteration.
+	Err error
+}
+
+// pageFetcher is implemented by every pager in this package.
+type pageFetcher[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// iteratePages returns an iterator that retrieves the pages of "pager" one at a
+// time and yields their results. If a page cannot be retrieved or "ctx" is
+// cancelled, the error is yielded with a zero result and the iteration stops.
+func iteratePages[T any](ctx context.Context, pager pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(zero, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+				return
+			}
+			for _, item := range page {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// streamPages runs iteratePages in a new goroutine and delivers the results on
+// the returned channel, which is closed when the iteration ends. The caller
+// must either receive until the channel is closed or cancel "ctx".
+func streamPages[T any](ctx context.Context, pager pageFetcher[T]) <-chan PagerItem[T] {
+	items := make(chan PagerItem[T])
+	go func() {
+		defer close(items)
+		for item, err := range iteratePages(ctx, pager) {
+			select {
+			case items <- PagerItem[T]{Item: item, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return items
+}
+
+// Iterate returns an iterator over the remaining results of the pager. Pages are
+// retrieved as the iteration progresses, so only one page is held in memory.
+func (pager *SecretsPager) Iterate(ctx context.Context) iter.Seq2[SecretMetadataIntf, error] {
+	return iteratePages[SecretMetadataIntf](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretsPager) Stream(ctx context.Context) <-chan PagerItem[SecretMetadataIntf] {
+	return streamPages[SecretMetadataIntf](ctx, pager)
+}
+
+// Iterate returns an iterator over the remaining results of the pager. Pages are
+// retrieved as the iteration progresses, so only one page is held in memory.
+func (pager *SecretsLocksPager) Iterate(ctx context.Context) iter.Seq2[SecretLocks, error] {
+	return iteratePages[SecretLocks](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretsLocksPager) Stream(ctx context.Context) <-chan PagerItem[SecretLocks] {
+	return streamPages[SecretLocks](ctx, pager)
+}
+
+// Iterate returns an iterator over the remaining results of the pager. Pages are
+// retrieved as the iteration progresses, so only one page is held in memory.
+func (pager *SecretLocksPager) Iterate(ctx context.Context) iter.Seq2[SecretLock, error] {
+	return iteratePages[SecretLock](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretLocksPager) Stream(ctx context.Context) <-chan PagerItem[SecretLock] {
+	return streamPages[SecretLock](ctx, pager)
+}
+
+// Iterate returns an iterator over the remaining results of the pager. Pages are
+// retrieved as the iteration progresses, so only one page is held in memory.
+func (pager *SecretVersionLocksPager) Iterate(ctx context.Context) iter.Seq2[SecretLock, error] {
+	return iteratePages[SecretLock](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *SecretVersionLocksPager) Stream(ctx context.Context) <-chan PagerItem[SecretLock] {
+	return streamPages[SecretLock](ctx, pager)
+}
+
+// Iterate returns an iterator over the remaining results of the pager. Pages are
+// retrieved as the iteration progresses, so only one page is held in memory.
+func (pager *ConfigurationsPager) Iterate(ctx context.Context) iter.Seq2[ConfigurationMetadataIntf, error] {
+	return iteratePages[ConfigurationMetadataIntf](ctx, pager)
+}
+
+// Stream delivers the remaining results of the pager on a channel. See Iterate.
+func (pager *ConfigurationsPager) Stream(ctx context.Context) <-chan PagerItem[ConfigurationMetadataIntf] {
+	return streamPages[ConfigurationMetadataIntf](ctx, pager)
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newListSecretsHandler returns a handler for the ListSecrets operation that
+// serves "total" arbitrary secrets named "secret-<index>", in pages of the
+// requested limit.
+func newListSecretsHandler(total int, requestCount *atomic.Int64) http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		Expect(req.URL.EscapedPath()).To(Equal("/api/v2/secrets"))
+		Expect(req.Method).To(Equal("GET"))
+		requestCount.Add(1)
+
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+		if limit == 0 {
+			limit = 200
+		}
+
+		var secrets []string
+		for i := offset; i < offset+limit && i < total; i++ {
+			secrets = append(secrets, fmt.Sprintf(`{"id": "id-%d", "name": "secret-%d", "secret_group_id": "default", "secret_type": "arbitrary"}`, i, i))
+		}
+		next := ""
+		if offset+limit < total {
+			next = fmt.Sprintf(`"next": {"href": "https://myhost.com/api/v2/secrets?limit=%d&offset=%d"}, `, limit, offset+limit)
+		}
+
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{%s"total_count": %d, "limit": %d, "offset": %d, "secrets": [%s]}`, next, total, limit, offset, strings.Join(secrets, ", "))
+	}
+}
+
+var _ = Describe(`Pager iterators`, func() {
+	var testServer *httptest.Server
+	var requestCount atomic.Int64
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+
+	BeforeEach(func() {
+		requestCount.Store(0)
+		testServer = httptest.NewServer(newListSecretsHandler(5, &requestCount))
+		var serviceErr error
+		secretsManagerService, serviceErr = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Use SecretsPager.Iterate successfully`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		var names []string
+		for secret, err := range pager.Iterate(context.Background()) {
+			Expect(err).To(BeNil())
+			names = append(names, *secret.(*secretsmanagerv2.ArbitrarySecretMetadata).Name)
+		}
+		Expect(names).To(Equal([]string{"secret-0", "secret-1", "secret-2", "secret-3", "secret-4"}))
+		Expect(requestCount.Load()).To(Equal(int64(3)))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Stop retrieving pages when the iteration is stopped early`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		count := 0
+		for _, err := range pager.Iterate(context.Background()) {
+			Expect(err).To(BeNil())
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		Expect(requestCount.Load()).To(Equal(int64(1)))
+	})
+	It(`Yield the context error when the context is cancelled`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+		var lastErr error
+		count := 0
+		for secret, err := range pager.Iterate(ctx) {
+			if err != nil {
+				Expect(secret).To(BeNil())
+				lastErr = err
+				continue
+			}
+			count++
+			cancelFunc()
+		}
+		Expect(count).To(Equal(1))
+		Expect(lastErr).To(Equal(context.Canceled))
+	})
+	It(`Use SecretsPager.Stream successfully`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		count := 0
+		for item := range pager.Stream(context.Background()) {
+			Expect(item.Err).To(BeNil())
+			Expect(item.Item).ToNot(BeNil())
+			count++
+		}
+		Expect(count).To(Equal(5))
+	})
+	It(`Deliver page errors on the stream`, func() {
+		testServer.Close()
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{})
+		Expect(err).To(BeNil())
+
+		var items []secretsmanagerv2.PagerItem[secretsmanagerv2.SecretMetadataIntf]
+		for item := range pager.Stream(context.Background()) {
+			items = append(items, item)
+		}
+		Expect(items).To(HaveLen(1))
+		Expect(items[0].Err).ToNot(BeNil())
+	})
+})
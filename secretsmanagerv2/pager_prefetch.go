@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultPrefetchConcurrency is the number of pages that are retrieved
+// concurrently when a concurrency of zero is passed to IterateConcurrently.
+const DefaultPrefetchConcurrency = 4
+
+type secretsPageResult struct {
+	secrets []SecretMetadataIntf
+	next    *int64
+	err     error
+}
+
+// IterateConcurrently is an alternate form of Iterate that retrieves pages in
+// parallel. The first page is retrieved on its own to learn the page size and
+// the total number of secrets; the offsets of the remaining pages are then
+// retrieved by up to "concurrency" concurrent requests. Results are still
+// yielded in order, and at most "concurrency" pages are held in memory.
+//
+// If secrets are added while the pages are retrieved, the pages beyond the
+// initial total count are retrieved sequentially at the end of the iteration.
+func (pager *SecretsPager) IterateConcurrently(ctx context.Context, concurrency int) iter.Seq2[SecretMetadataIntf, error] {
+	if concurrency <= 0 {
+		concurrency = DefaultPrefetchConcurrency
+	}
+	return func(yield func(SecretMetadataIntf, error) bool) {
+		if !pager.HasNext() {
+			return
+		}
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		first, err := pager.fetchPage(ctx, *pager.options, pager.pageContext.next)
+		if err != nil {
+			yield(nil, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+			return
+		}
+		next, err := first.GetNextOffset()
+		if err != nil {
+			yield(nil, core.RepurposeSDKProblem(err, "get-query-error"))
+			return
+		}
+		pager.pageContext.next = next
+		pager.hasNext = (next != nil)
+		for _, secret := range first.Secrets {
+			if !yield(secret, nil) {
+				return
+			}
+		}
+
+		pageSize := int64(len(first.Secrets))
+		if first.Limit != nil && *first.Limit > 0 {
+			pageSize = *first.Limit
+		}
+		if next != nil && first.TotalCount != nil && pageSize > 0 {
+			var offsets []int64
+			for offset := *next; offset < *first.TotalCount; offset += pageSize {
+				offsets = append(offsets, offset)
+			}
+			if !pager.yieldPages(ctx, offsets, concurrency, yield) {
+				return
+			}
+		}
+
+		// Retrieve any remaining pages sequentially.
+		for secret, err := range pager.Iterate(ctx) {
+			if !yield(secret, err) {
+				return
+			}
+		}
+	}
+}
+
+// yieldPages retrieves the pages at "offsets" with a bounded number of
+// concurrent requests and yields their results in order. It returns false if
+// the iteration was stopped by the consumer or by an error.
+func (pager *SecretsPager) yieldPages(ctx context.Context, offsets []int64, concurrency int, yield func(SecretMetadataIntf, error) bool) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each page has its own single-use slot so that workers never block, and the
+	// semaphore limits the number of pages that are retrieved but not yet yielded.
+	slots := make([]chan secretsPageResult, len(offsets))
+	for i := range slots {
+		slots[i] = make(chan secretsPageResult, 1)
+	}
+	// The workers may outlive this call, so they use their own copy of the options.
+	options := *pager.options
+	semaphore := make(chan struct{}, concurrency)
+	go func() {
+		for i, offset := range offsets {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(slot chan<- secretsPageResult, offset int64) {
+				result, err := pager.fetchPage(ctx, options, &offset)
+				if err != nil {
+					slot <- secretsPageResult{err: core.RepurposeSDKProblem(err, "error-getting-next-page")}
+					return
+				}
+				next, err := result.GetNextOffset()
+				if err != nil {
+					slot <- secretsPageResult{err: core.RepurposeSDKProblem(err, "get-query-error")}
+					return
+				}
+				slot <- secretsPageResult{secrets: result.Secrets, next: next}
+			}(slots[i], offset)
+		}
+	}()
+
+	for _, slot := range slots {
+		var page secretsPageResult
+		select {
+		case page = <-slot:
+		case <-ctx.Done():
+			yield(nil, ctx.Err())
+			return false
+		}
+		<-semaphore
+
+		if page.err != nil {
+			yield(nil, page.err)
+			return false
+		}
+		pager.pageContext.next = page.next
+		pager.hasNext = (page.next != nil)
+		for _, secret := range page.secrets {
+			if !yield(secret, nil) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// fetchPage retrieves the page at "offset" using a copy of the options of the pager.
+func (pager *SecretsPager) fetchPage(ctx context.Context, options ListSecretsOptions, offset *int64) (result *SecretMetadataPaginatedCollection, err error) {
+	options.Offset = offset
+	result, _, err = pager.client.ListSecretsWithContext(ctx, &options)
+	if err == nil && result == nil {
+		result = new(SecretMetadataPaginatedCollection)
+	}
+	return
+}
+
+// GetAllConcurrentlyWithContext returns all results by invoking IterateConcurrently().
+func (pager *SecretsPager) GetAllConcurrentlyWithContext(ctx context.Context, concurrency int) (allItems []SecretMetadataIntf, err error) {
+	for secret, iterErr := range pager.IterateConcurrently(ctx, concurrency) {
+		if iterErr != nil {
+			err = core.RepurposeSDKProblem(iterErr, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, secret)
+	}
+	return
+}
+
+// GetAllConcurrently invokes GetAllConcurrentlyWithContext() using context.Background() as the Context parameter.
+func (pager *SecretsPager) GetAllConcurrently(concurrency int) (allItems []SecretMetadataIntf, err error) {
+	allItems, err = pager.GetAllConcurrentlyWithContext(context.Background(), concurrency)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
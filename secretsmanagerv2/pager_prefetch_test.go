@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SecretsPager concurrent prefetch`, func() {
+	var testServer *httptest.Server
+	var requestCount atomic.Int64
+	var inFlight atomic.Int64
+	var maxInFlight atomic.Int64
+	var failOffset string
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+
+	BeforeEach(func() {
+		requestCount.Store(0)
+		inFlight.Store(0)
+		maxInFlight.Store(0)
+		failOffset = ""
+		listSecretsHandler := newListSecretsHandler(11, &requestCount)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				observed := maxInFlight.Load()
+				if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			// Delay later pages more than earlier ones so that they complete out of order.
+			if offset := req.URL.Query().Get("offset"); offset != "" {
+				if offset == failOffset {
+					res.WriteHeader(500)
+					return
+				}
+				var index int
+				fmt.Sscanf(offset, "%d", &index)
+				time.Sleep(time.Duration(50-4*index) * time.Millisecond)
+			}
+			listSecretsHandler(res, req)
+		}))
+		var serviceErr error
+		secretsManagerService, serviceErr = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Use SecretsPager.GetAllConcurrently successfully`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		allResults, err := pager.GetAllConcurrently(3)
+		Expect(err).To(BeNil())
+		Expect(allResults).To(HaveLen(11))
+		for i, secret := range allResults {
+			Expect(*secret.(*secretsmanagerv2.ArbitrarySecretMetadata).Name).To(Equal(fmt.Sprintf("secret-%d", i)))
+		}
+		Expect(requestCount.Load()).To(Equal(int64(6)))
+		Expect(maxInFlight.Load()).To(BeNumerically(">", 1))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 3))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Stop prefetching when the iteration is stopped early`, func() {
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		count := 0
+		for _, err := range pager.IterateConcurrently(context.Background(), 2) {
+			Expect(err).To(BeNil())
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		Expect(requestCount.Load()).To(BeNumerically("<=", 4))
+		Expect(pager.HasNext()).To(BeTrue())
+	})
+	It(`Use SecretsPager.GetAllConcurrently with error: page request failed`, func() {
+		failOffset = "6"
+		pager, err := secretsManagerService.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+			Limit: core.Int64Ptr(int64(2)),
+		})
+		Expect(err).To(BeNil())
+
+		allResults, err := pager.GetAllConcurrently(0)
+		Expect(err).ToNot(BeNil())
+		Expect(allResults).To(HaveLen(6))
+
+		// The pager can continue from the page that failed.
+		failOffset = ""
+		remaining, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(remaining).To(HaveLen(5))
+	})
+})
@@ -0,0 +1,386 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultCircuitBreakerFailureThreshold is the number of consecutive failures
+// that opens a circuit when CircuitBreakerOptions.FailureThreshold is not set.
+const DefaultCircuitBreakerFailureThreshold = 5
+
+// DefaultCircuitBreakerOpenPeriod is the time that a circuit stays open when
+// CircuitBreakerOptions.OpenPeriod is not set.
+const DefaultCircuitBreakerOpenPeriod = 30 * time.Second
+
+// The states of a circuit breaker.
+const (
+	// CircuitClosed is the state of a circuit that lets requests through.
+	CircuitClosed = "closed"
+
+	// CircuitOpen is the state of a circuit that rejects requests.
+	CircuitOpen = "open"
+
+	// CircuitHalfOpen is the state of a circuit that lets one trial request through after its open period.
+	CircuitHalfOpen = "half_open"
+)
+
+// ErrCircuitOpen is the cause of the errors of the requests that are rejected
+// because the circuit breaker of the client is open. Use errors.Is to detect it.
+var ErrCircuitOpen = errors.New("the circuit breaker of the client is open")
+
+// RateLimit : A token bucket rate limit of requests. Requests that exceed the rate limit wait until they are
+// within it, or until their context is done.
+type RateLimit struct {
+	// The number of requests per second that are let through on average.
+	RequestsPerSecond float64 `validate:"gt=0"`
+
+	// The number of requests that can be let through at once, after a period without requests. If it is zero, the burst
+	// is one request.
+	Burst int `validate:"gte=0"`
+}
+
+// CircuitBreakerOptions : The options of the circuit breaker of a client. The circuit opens after consecutive
+// failed requests: requests that get a 429 or 5xx status code, or no response. While it is open, requests fail
+// with ErrCircuitOpen without being sent. After the open period, one trial request is let through; the circuit
+// closes if it succeeds and opens again if it fails.
+type CircuitBreakerOptions struct {
+	// The number of consecutive failed requests that opens the circuit. If it is zero,
+	// DefaultCircuitBreakerFailureThreshold is used.
+	FailureThreshold int `validate:"gte=0"`
+
+	// The time that the circuit stays open. If it is zero, DefaultCircuitBreakerOpenPeriod is used.
+	OpenPeriod time.Duration `validate:"gte=0"`
+}
+
+// RequestStats : The counters of the rate limits and of the circuit breaker of a client.
+type RequestStats struct {
+	// The number of requests that were sent.
+	Requests int64
+
+	// The number of requests that waited for a rate limit.
+	Throttled int64
+
+	// The total time that requests waited for rate limits.
+	ThrottledTime time.Duration
+
+	// The number of requests that failed with a 429 or 5xx status code, or without a response.
+	Failures int64
+
+	// The number of requests that were rejected because the circuit was open.
+	Rejected int64
+
+	// The number of times that the circuit opened.
+	CircuitOpenings int64
+
+	// The state of the circuit: CircuitClosed, CircuitOpen or CircuitHalfOpen, or an empty string if the client has
+	// no circuit breaker.
+	CircuitState string
+}
+
+// requestLimits holds the rate limits, the circuit breaker and the counters
+// of a client.
+type requestLimits struct {
+	rateLimit       *tokenBucket
+	operationLimits map[string]*tokenBucket
+	circuitBreaker  *circuitBreaker
+
+	requests      atomic.Int64
+	throttled     atomic.Int64
+	throttledTime atomic.Int64
+	failures      atomic.Int64
+	rejected      atomic.Int64
+}
+
+// newRequestLimits returns the request limits of the options of a client, or
+// nil if the options set none.
+func newRequestLimits(options *SecretsManagerV2Options) (limits *requestLimits, err error) {
+	if options.RateLimit == nil && len(options.OperationRateLimits) == 0 && options.CircuitBreaker == nil {
+		return
+	}
+
+	limits = &requestLimits{operationLimits: map[string]*tokenBucket{}}
+	if options.RateLimit != nil {
+		limits.rateLimit, err = newTokenBucket(options.RateLimit, "options.RateLimit")
+		if err != nil {
+			return nil, err
+		}
+	}
+	for operation, rateLimit := range options.OperationRateLimits {
+		if !isOperation(operation) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the operation '%s' of 'options.OperationRateLimits' is not an operation of the client", operation), "invalid-operation", common.GetComponentInfo())
+			return nil, err
+		}
+		limits.operationLimits[operation], err = newTokenBucket(&rateLimit, fmt.Sprintf("options.OperationRateLimits[%s]", operation))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if options.CircuitBreaker != nil {
+		err = core.ValidateStruct(options.CircuitBreaker, "options.CircuitBreaker")
+		if err != nil {
+			err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+			return nil, err
+		}
+		limits.circuitBreaker = &circuitBreaker{
+			failureThreshold: options.CircuitBreaker.FailureThreshold,
+			openPeriod:       options.CircuitBreaker.OpenPeriod,
+			state:            CircuitClosed,
+		}
+		if limits.circuitBreaker.failureThreshold == 0 {
+			limits.circuitBreaker.failureThreshold = DefaultCircuitBreakerFailureThreshold
+		}
+		if limits.circuitBreaker.openPeriod == 0 {
+			limits.circuitBreaker.openPeriod = DefaultCircuitBreakerOpenPeriod
+		}
+	}
+	return
+}
+
+func isOperation(operation string) bool {
+	for _, route := range operationRoutes {
+		if route.operation == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// roundTrip sends a request of "operation" with "next" within the limits.
+func (limits *requestLimits) roundTrip(req *http.Request, operation string, next http.RoundTripper) (*http.Response, error) {
+	var trial bool
+	if limits.circuitBreaker != nil {
+		var allowed bool
+		if allowed, trial = limits.circuitBreaker.allow(time.Now()); !allowed {
+			limits.rejected.Add(1)
+			return nil, ErrCircuitOpen
+		}
+	}
+	if err := limits.wait(req, operation); err != nil {
+		if limits.circuitBreaker != nil {
+			limits.circuitBreaker.release(trial)
+		}
+		return nil, err
+	}
+
+	limits.requests.Add(1)
+	res, err := next.RoundTrip(req)
+	failed := err != nil || res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	if failed && req.Context().Err() == nil {
+		limits.failures.Add(1)
+	}
+	if limits.circuitBreaker != nil {
+		if req.Context().Err() != nil {
+			// The caller gave up on the request, which says nothing about the service.
+			limits.circuitBreaker.release(trial)
+		} else {
+			limits.circuitBreaker.record(!failed, trial, time.Now())
+		}
+	}
+	return res, err
+}
+
+// wait waits until a request of "operation" is within the rate limit of the
+// client and the rate limit of the operation.
+func (limits *requestLimits) wait(req *http.Request, operation string) error {
+	var buckets []*tokenBucket
+	if limits.rateLimit != nil {
+		buckets = append(buckets, limits.rateLimit)
+	}
+	if bucket, ok := limits.operationLimits[operation]; ok {
+		buckets = append(buckets, bucket)
+	}
+
+	var delay time.Duration
+	now := time.Now()
+	for _, bucket := range buckets {
+		delay = max(delay, bucket.reserve(now))
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	limits.throttled.Add(1)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		limits.throttledTime.Add(int64(delay))
+		return nil
+	case <-req.Context().Done():
+		limits.throttledTime.Add(int64(time.Since(now)))
+		for _, bucket := range buckets {
+			bucket.cancel()
+		}
+		return req.Context().Err()
+	}
+}
+
+func (limits *requestLimits) stats() RequestStats {
+	stats := RequestStats{
+		Requests:      limits.requests.Load(),
+		Throttled:     limits.throttled.Load(),
+		ThrottledTime: time.Duration(limits.throttledTime.Load()),
+		Failures:      limits.failures.Load(),
+		Rejected:      limits.rejected.Load(),
+	}
+	if limits.circuitBreaker != nil {
+		stats.CircuitState, stats.CircuitOpenings = limits.circuitBreaker.snapshot(time.Now())
+	}
+	return stats
+}
+
+// RequestStats returns the counters of the rate limits and of the circuit
+// breaker of the client. They are all zero if the client was constructed
+// without SecretsManagerV2Options.RateLimit, OperationRateLimits and
+// CircuitBreaker.
+func (secretsManager *SecretsManagerV2) RequestStats() RequestStats {
+	if secretsManager.transport == nil || secretsManager.transport.limits == nil {
+		return RequestStats{}
+	}
+	return secretsManager.transport.limits.stats()
+}
+
+// tokenBucket is a token bucket rate limiter. Requests reserve tokens ahead
+// of time, so the token count is negative while requests are waiting.
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rateLimit *RateLimit, name string) (*tokenBucket, error) {
+	err := core.ValidateStruct(rateLimit, name)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	burst := float64(max(rateLimit.Burst, 1))
+	return &tokenBucket{rate: rateLimit.RequestsPerSecond, burst: burst, tokens: burst}, nil
+}
+
+// reserve takes a token and returns how long the request has to wait for it.
+func (bucket *tokenBucket) reserve(now time.Time) time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	if !bucket.last.IsZero() {
+		bucket.tokens = math.Min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	}
+	bucket.last = now
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// cancel returns the token of a request that gave up waiting.
+func (bucket *tokenBucket) cancel() {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+1)
+}
+
+// circuitBreaker opens after consecutive failures and lets one trial request
+// through after its open period.
+type circuitBreaker struct {
+	failureThreshold int
+	openPeriod       time.Duration
+
+	mutex               sync.Mutex
+	state               string
+	consecutiveFailures int
+	openedAt            time.Time
+	trialInFlight       bool
+	openings            int64
+}
+
+// allow returns whether a request can be sent, and whether it is the trial
+// request of a half-open circuit.
+func (breaker *circuitBreaker) allow(now time.Time) (allowed bool, trial bool) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	if breaker.state == CircuitOpen && now.Sub(breaker.openedAt) >= breaker.openPeriod {
+		breaker.state = CircuitHalfOpen
+	}
+	switch breaker.state {
+	case CircuitOpen:
+		return false, false
+	case CircuitHalfOpen:
+		if breaker.trialInFlight {
+			return false, false
+		}
+		breaker.trialInFlight = true
+		return true, true
+	}
+	return true, false
+}
+
+// record records the outcome of a request that was allowed. While the circuit
+// is not closed, only the outcome of the trial request counts: the other
+// requests were sent before the circuit opened.
+func (breaker *circuitBreaker) record(success bool, trial bool, now time.Time) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	if trial {
+		breaker.trialInFlight = false
+	} else if breaker.state != CircuitClosed {
+		return
+	}
+	if success {
+		breaker.consecutiveFailures = 0
+		breaker.state = CircuitClosed
+		return
+	}
+	breaker.consecutiveFailures++
+	if breaker.state == CircuitHalfOpen || breaker.state == CircuitClosed && breaker.consecutiveFailures >= breaker.failureThreshold {
+		breaker.state = CircuitOpen
+		breaker.openedAt = now
+		breaker.openings++
+	}
+}
+
+// release releases a request that was allowed but has no outcome.
+func (breaker *circuitBreaker) release(trial bool) {
+	if !trial {
+		return
+	}
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	breaker.trialInFlight = false
+}
+
+func (breaker *circuitBreaker) snapshot(now time.Time) (state string, openings int64) {
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+	state = breaker.state
+	if state == CircuitOpen && now.Sub(breaker.openedAt) >= breaker.openPeriod {
+		state = CircuitHalfOpen
+	}
+	return state, breaker.openings
+}
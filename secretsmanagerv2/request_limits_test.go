@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Request limits`, func() {
+	var server *smtest.Server
+	var failing atomic.Bool
+	var requests atomic.Int64
+
+	newClient := func(options *secretsmanagerv2.SecretsManagerV2Options) *secretsmanagerv2.SecretsManagerV2 {
+		options.URL = server.URL
+		options.Authenticator = &core.NoAuthAuthenticator{}
+		secretsManagerService, err := secretsmanagerv2.NewSecretsManagerV2(options)
+		Expect(err).To(BeNil())
+		return secretsManagerService
+	}
+	getDefaultGroup := func(secretsManagerService *secretsmanagerv2.SecretsManagerV2) error {
+		_, _, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions("default"))
+		return err
+	}
+
+	BeforeEach(func() {
+		failing.Store(false)
+		requests.Store(0)
+		server = smtest.NewUnstartedServer()
+		server.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			if strings.HasPrefix(path.Base(req.URL.Path), "slow") {
+				time.Sleep(250 * time.Millisecond)
+			}
+			if failing.Load() {
+				res.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			server.ServeHTTP(res, req)
+		})
+		server.Start()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Rate limit the requests of the client`, func() {
+		secretsManagerService := newClient(&secretsmanagerv2.SecretsManagerV2Options{
+			RateLimit: &secretsmanagerv2.RateLimit{RequestsPerSecond: 20, Burst: 2},
+		})
+
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			Expect(getDefaultGroup(secretsManagerService)).To(Succeed())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 180*time.Millisecond))
+
+		stats := secretsManagerService.RequestStats()
+		Expect(stats.Requests).To(Equal(int64(6)))
+		Expect(stats.Throttled).To(Equal(int64(4)))
+		Expect(stats.ThrottledTime).To(BeNumerically(">=", 180*time.Millisecond))
+		Expect(stats.CircuitState).To(BeEmpty())
+	})
+	It(`Rate limit the requests of individual operations`, func() {
+		secretsManagerService := newClient(&secretsmanagerv2.SecretsManagerV2Options{
+			OperationRateLimits: map[string]secretsmanagerv2.RateLimit{
+				"GetSecretGroup": {RequestsPerSecond: 1},
+			},
+		})
+		Expect(getDefaultGroup(secretsManagerService)).To(Succeed())
+
+		// Other operations are not limited.
+		for i := 0; i < 3; i++ {
+			_, _, err := secretsManagerService.ListSecretGroups(&secretsmanagerv2.ListSecretGroupsOptions{})
+			Expect(err).To(BeNil())
+		}
+		Expect(secretsManagerService.RequestStats().Throttled).To(BeZero())
+
+		// Requests give up waiting when their context is done.
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, response, err := secretsManagerService.GetSecretGroupWithContext(ctx, secretsManagerService.NewGetSecretGroupOptions("default"))
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(response).To(BeNil())
+		Expect(secretsManagerService.RequestStats().Throttled).To(Equal(int64(1)))
+		Expect(requests.Load()).To(Equal(int64(4)))
+	})
+	It(`Open the circuit after consecutive failures`, func() {
+		secretsManagerService := newClient(&secretsmanagerv2.SecretsManagerV2Options{
+			CircuitBreaker: &secretsmanagerv2.CircuitBreakerOptions{FailureThreshold: 2, OpenPeriod: 100 * time.Millisecond},
+		})
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitClosed))
+
+		// Client errors are not failures.
+		_, _, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions("missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(secretsManagerService.RequestStats().Failures).To(BeZero())
+
+		failing.Store(true)
+		Expect(getDefaultGroup(secretsManagerService)).ToNot(Succeed())
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitClosed))
+		Expect(getDefaultGroup(secretsManagerService)).ToNot(Succeed())
+
+		err = getDefaultGroup(secretsManagerService)
+		Expect(errors.Is(err, secretsmanagerv2.ErrCircuitOpen)).To(BeTrue())
+		Expect(requests.Load()).To(Equal(int64(3)))
+		stats := secretsManagerService.RequestStats()
+		Expect(stats.CircuitState).To(Equal(secretsmanagerv2.CircuitOpen))
+		Expect(stats.CircuitOpenings).To(Equal(int64(1)))
+		Expect(stats.Failures).To(Equal(int64(2)))
+		Expect(stats.Rejected).To(Equal(int64(1)))
+
+		// A failed trial request opens the circuit again.
+		time.Sleep(100 * time.Millisecond)
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitHalfOpen))
+		Expect(getDefaultGroup(secretsManagerService)).ToNot(Succeed())
+		Expect(requests.Load()).To(Equal(int64(4)))
+		Expect(secretsManagerService.RequestStats().CircuitOpenings).To(Equal(int64(2)))
+
+		// A successful trial request closes it.
+		failing.Store(false)
+		Eventually(func() error { return getDefaultGroup(secretsManagerService) }, time.Second, 20*time.Millisecond).Should(Succeed())
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitClosed))
+		Expect(getDefaultGroup(secretsManagerService)).To(Succeed())
+	})
+	It(`Let only the trial request close a half-open circuit`, func() {
+		secretsManagerService := newClient(&secretsmanagerv2.SecretsManagerV2Options{
+			CircuitBreaker: &secretsmanagerv2.CircuitBreakerOptions{FailureThreshold: 1, OpenPeriod: 100 * time.Millisecond},
+		})
+		getGroup := func(id string) error {
+			_, response, err := secretsManagerService.GetSecretGroup(secretsManagerService.NewGetSecretGroupOptions(id))
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		// A request that was sent before the circuit opened completes while the trial request is in flight.
+		staleDone := make(chan error, 1)
+		go func() { staleDone <- getGroup("slow-stale") }()
+		failing.Store(true)
+		Expect(getDefaultGroup(secretsManagerService)).ToNot(Succeed())
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitOpen))
+
+		time.Sleep(100 * time.Millisecond)
+		trialDone := make(chan error, 1)
+		go func() { trialDone <- getGroup("slow-trial") }()
+		Eventually(requests.Load).Should(Equal(int64(3)))
+		failing.Store(false)
+		Eventually(staleDone).Should(Receive(BeNil()))
+
+		err := getDefaultGroup(secretsManagerService)
+		Expect(errors.Is(err, secretsmanagerv2.ErrCircuitOpen)).To(BeTrue())
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitHalfOpen))
+
+		Eventually(trialDone).Should(Receive(BeNil()))
+		Expect(secretsManagerService.RequestStats().CircuitState).To(Equal(secretsmanagerv2.CircuitClosed))
+		Expect(secretsManagerService.RequestStats().CircuitOpenings).To(Equal(int64(1)))
+	})
+	It(`Apply the limits to each attempt of automatic retries`, func() {
+		secretsManagerService := newClient(&secretsmanagerv2.SecretsManagerV2Options{
+			CircuitBreaker: &secretsmanagerv2.CircuitBreakerOptions{FailureThreshold: 2, OpenPeriod: time.Minute},
+		})
+		secretsManagerService.EnableRetries(3, 10*time.Millisecond)
+
+		failing.Store(true)
+		err := getDefaultGroup(secretsManagerService)
+		Expect(errors.Is(err, secretsmanagerv2.ErrCircuitOpen)).To(BeTrue())
+		Expect(requests.Load()).To(Equal(int64(2)))
+	})
+	It(`Disable the SSL verification of the wrapped transport`, func() {
+		tlsServer := smtest.NewUnstartedServer()
+		tlsServer.StartTLS()
+		defer tlsServer.Close()
+		secretsManagerService, err := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           tlsServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimit:     &secretsmanagerv2.RateLimit{RequestsPerSecond: 100},
+		})
+		Expect(err).To(BeNil())
+		Expect(getDefaultGroup(secretsManagerService)).ToNot(Succeed())
+		Expect(secretsManagerService.IsSSLDisabled()).To(BeFalse())
+
+		secretsManagerService.DisableSSLVerification()
+		Expect(secretsManagerService.IsSSLDisabled()).To(BeTrue())
+		Expect(getDefaultGroup(secretsManagerService)).To(Succeed())
+		Expect(secretsManagerService.RequestStats().Requests).To(Equal(int64(2)))
+	})
+	It(`Invoke NewSecretsManagerV2 with error: invalid limits`, func() {
+		for _, options := range []*secretsmanagerv2.SecretsManagerV2Options{
+			{RateLimit: &secretsmanagerv2.RateLimit{}},
+			{RateLimit: &secretsmanagerv2.RateLimit{RequestsPerSecond: 1, Burst: -1}},
+			{OperationRateLimits: map[string]secretsmanagerv2.RateLimit{"GetSecrets": {RequestsPerSecond: 1}}},
+			{CircuitBreaker: &secretsmanagerv2.CircuitBreakerOptions{OpenPeriod: -time.Second}},
+		} {
+			options.Authenticator = &core.NoAuthAuthenticator{}
+			_, err := secretsmanagerv2.NewSecretsManagerV2(options)
+			Expect(err).ToNot(BeNil())
+		}
+	})
+})
@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultBatchConcurrency is the number of secrets that are retrieved
+// concurrently by GetSecretsBatch when GetSecretsBatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency = 8
+
+// SecretReference identifies a secret either by its ID, or by its type, the
+// name of its secret group and its name.
+type SecretReference struct {
+	// The ID of the secret.
+	ID string
+
+	// The secret type, when the secret is identified by its name.
+	SecretType string
+
+	// The name of the secret group, when the secret is identified by its name.
+	// The default secret group is used if it is empty.
+	SecretGroupName string
+
+	// The name of the secret.
+	Name string
+}
+
+// SecretReferenceByID returns a reference to the secret with the ID "id".
+func SecretReferenceByID(id string) SecretReference {
+	return SecretReference{ID: id}
+}
+
+// SecretReferenceByName returns a reference to the secret of type
+// "secretType" with the name "name" in the secret group "secretGroupName".
+func SecretReferenceByName(secretType string, secretGroupName string, name string) SecretReference {
+	return SecretReference{SecretType: secretType, SecretGroupName: secretGroupName, Name: name}
+}
+
+// String returns the ID of the secret, or "<secret_group_name>/<secret_type>/<name>".
+func (ref SecretReference) String() string {
+	if ref.ID != "" {
+		return ref.ID
+	}
+	return fmt.Sprintf("%s/%s/%s", ref.secretGroupName(), ref.SecretType, ref.Name)
+}
+
+func (ref SecretReference) secretGroupName() string {
+	if ref.SecretGroupName == "" {
+		return "default"
+	}
+	return ref.SecretGroupName
+}
+
+func (ref SecretReference) validate() error {
+	if ref.ID != "" {
+		if ref.SecretType != "" || ref.SecretGroupName != "" || ref.Name != "" {
+			return fmt.Errorf("the secret reference '%s' must specify either an ID or a type and a name, not both", ref)
+		}
+		return nil
+	}
+	if ref.SecretType == "" || ref.Name == "" {
+		return fmt.Errorf("the secret reference '%s' must specify an ID, or a secret type and a name", ref)
+	}
+	return nil
+}
+
+// GetSecretsBatchOptions : The GetSecretsBatch options.
+type GetSecretsBatchOptions struct {
+	// The secrets to retrieve. Duplicate references are retrieved once; a reference with an empty secret group name
+	// is a duplicate of the same reference with the "default" secret group name, and they share their result.
+	References []SecretReference `validate:"required,min=1"`
+
+	// The maximum number of secrets that are retrieved concurrently. If it is
+	// zero, DefaultBatchConcurrency is used.
+	Concurrency int `validate:"min=0"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetSecretsBatchOptions : Instantiate GetSecretsBatchOptions
+func (*SecretsManagerV2) NewGetSecretsBatchOptions(references []SecretReference) *GetSecretsBatchOptions {
+	return &GetSecretsBatchOptions{
+		References: references,
+	}
+}
+
+// SetReferences : Allow user to set References
+func (options *GetSecretsBatchOptions) SetReferences(references []SecretReference) *GetSecretsBatchOptions {
+	options.References = references
+	return options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (options *GetSecretsBatchOptions) SetConcurrency(concurrency int) *GetSecretsBatchOptions {
+	options.Concurrency = concurrency
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetSecretsBatchOptions) SetHeaders(param map[string]string) *GetSecretsBatchOptions {
+	options.Headers = param
+	return options
+}
+
+// SecretBatchResult : The outcome of retrieving one secret of a batch.
+type SecretBatchResult struct {
+	// The secret, if it was retrieved.
+	Secret SecretIntf
+
+	// The response of the request for the secret, if one was received.
+	Response *core.DetailedResponse
+
+	// The error that occurred while the secret was retrieved.
+	Err error
+}
+
+// SecretBatchResults : The outcome of retrieving each secret of a batch, keyed by its reference.
+type SecretBatchResults map[SecretReference]*SecretBatchResult
+
+// Err returns an error that combines the errors of the secrets that could not
+// be retrieved, or nil if every secret was retrieved.
+func (results SecretBatchResults) Err() error {
+	var errs []error
+	for ref, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ref, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetSecretsBatch : Get several secrets concurrently
+// Retrieve the secrets in "getSecretsBatchOptions.References" with up to
+// "getSecretsBatchOptions.Concurrency" concurrent requests. The outcome of each
+// secret is reported separately in the results; an error is returned only if
+// the options are not valid.
+//
+// Each request is sent by GetSecretWithContext or GetSecretByNameTypeWithContext,
+// so the retry settings of EnableRetries apply to every secret.
+func (secretsManager *SecretsManagerV2) GetSecretsBatch(getSecretsBatchOptions *GetSecretsBatchOptions) (results SecretBatchResults, err error) {
+	results, err = secretsManager.GetSecretsBatchWithContext(context.Background(), getSecretsBatchOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretsBatchWithContext is an alternate form of the GetSecretsBatch method which supports a Context parameter
+func (secretsManager *SecretsManagerV2) GetSecretsBatchWithContext(ctx context.Context, getSecretsBatchOptions *GetSecretsBatchOptions) (results SecretBatchResults, err error) {
+	err = core.ValidateNotNil(getSecretsBatchOptions, "getSecretsBatchOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSecretsBatchOptions, "getSecretsBatchOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	concurrency := getSecretsBatchOptions.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results = make(SecretBatchResults, len(getSecretsBatchOptions.References))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	retrieved := make(map[SecretReference]*SecretBatchResult, len(getSecretsBatchOptions.References))
+	for _, ref := range getSecretsBatchOptions.References {
+		key := ref
+		if key.ID == "" {
+			key.SecretGroupName = ref.secretGroupName()
+		}
+		if result, ok := retrieved[key]; ok {
+			results[ref] = result
+			continue
+		}
+		result := &SecretBatchResult{}
+		retrieved[key] = result
+		results[ref] = result
+		if result.Err = ref.validate(); result.Err != nil {
+			result.Err = core.SDKErrorf(result.Err, "", "invalid-secret-reference", common.GetComponentInfo())
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			result.Err = core.SDKErrorf(ctx.Err(), "", "batch-canceled", common.GetComponentInfo())
+			continue
+		}
+		wg.Add(1)
+		go func(ref SecretReference, result *SecretBatchResult) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			result.Secret, result.Response, result.Err = secretsManager.getSecretByReference(ctx, ref, getSecretsBatchOptions.Headers)
+		}(ref, result)
+	}
+	wg.Wait()
+	return
+}
+
+func (secretsManager *SecretsManagerV2) getSecretByReference(ctx context.Context, ref SecretReference, headers map[string]string) (SecretIntf, *core.DetailedResponse, error) {
+	if ref.ID != "" {
+		getSecretOptions := secretsManager.NewGetSecretOptions(ref.ID)
+		getSecretOptions.Headers = headers
+		return secretsManager.GetSecretWithContext(ctx, getSecretOptions)
+	}
+	getSecretByNameTypeOptions := secretsManager.NewGetSecretByNameTypeOptions(ref.SecretType, ref.Name, ref.secretGroupName())
+	getSecretByNameTypeOptions.Headers = headers
+	return secretsManager.GetSecretByNameTypeWithContext(ctx, getSecretByNameTypeOptions)
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetSecretsBatch`, func() {
+	var server *smtest.Server
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+	var secretIDs []string
+	var inFlight, maxInFlight, failuresLeft atomic.Int64
+
+	BeforeEach(func() {
+		inFlight.Store(0)
+		maxInFlight.Store(0)
+		failuresLeft.Store(0)
+		server = smtest.NewUnstartedServer()
+		server.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodGet {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					observed := maxInFlight.Load()
+					if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				if failuresLeft.Add(-1) >= 0 {
+					res.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+			}
+			server.ServeHTTP(res, req)
+		})
+		server.Start()
+
+		var err error
+		secretsManagerService, err = server.NewClient()
+		Expect(err).To(BeNil())
+
+		secretIDs = nil
+		for i := 0; i < 6; i++ {
+			prototype, err := secretsManagerService.NewArbitrarySecretPrototype(fmt.Sprintf("secret-%d", i), secretsmanagerv2.Secret_SecretType_Arbitrary, fmt.Sprintf("payload-%d", i))
+			Expect(err).To(BeNil())
+			secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(prototype))
+			Expect(err).To(BeNil())
+			secretIDs = append(secretIDs, *secret.(*secretsmanagerv2.ArbitrarySecret).ID)
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Retrieve secrets by ID and by name with bounded concurrency`, func() {
+		references := []secretsmanagerv2.SecretReference{
+			secretsmanagerv2.SecretReferenceByName(secretsmanagerv2.Secret_SecretType_Arbitrary, "", "secret-0"),
+		}
+		for _, id := range secretIDs[1:] {
+			references = append(references, secretsmanagerv2.SecretReferenceByID(id))
+		}
+		references = append(references,
+			secretsmanagerv2.SecretReferenceByID(secretIDs[1]),
+			secretsmanagerv2.SecretReferenceByName(secretsmanagerv2.Secret_SecretType_Arbitrary, "default", "secret-0"),
+		)
+
+		options := secretsManagerService.NewGetSecretsBatchOptions(references).SetConcurrency(3)
+		results, err := secretsManagerService.GetSecretsBatch(options)
+		Expect(err).To(BeNil())
+		Expect(results.Err()).To(BeNil())
+		Expect(results).To(HaveLen(7))
+		Expect(*results[references[0]].Secret.(*secretsmanagerv2.ArbitrarySecret).Payload).To(Equal("payload-0"))
+		Expect(results[references[7]]).To(BeIdenticalTo(results[references[0]]))
+		Expect(*results[secretsmanagerv2.SecretReferenceByID(secretIDs[5])].Secret.(*secretsmanagerv2.ArbitrarySecret).Payload).To(Equal("payload-5"))
+		Expect(maxInFlight.Load()).To(BeNumerically(">", 1))
+		Expect(maxInFlight.Load()).To(BeNumerically("<=", 3))
+	})
+	It(`Report the errors of each secret separately`, func() {
+		missing := secretsmanagerv2.SecretReferenceByID("b49ad24d-81d4-5ebc-b9b9-b0937d1c84d5")
+		invalid := secretsmanagerv2.SecretReference{Name: "secret-0"}
+		found := secretsmanagerv2.SecretReferenceByID(secretIDs[0])
+
+		results, err := secretsManagerService.GetSecretsBatch(secretsManagerService.NewGetSecretsBatchOptions([]secretsmanagerv2.SecretReference{missing, invalid, found}))
+		Expect(err).To(BeNil())
+		Expect(results[found].Err).To(BeNil())
+		Expect(results[missing].Err).ToNot(BeNil())
+		Expect(results[missing].Response.StatusCode).To(Equal(404))
+		Expect(results[invalid].Err).ToNot(BeNil())
+		Expect(results[invalid].Response).To(BeNil())
+		Expect(results.Err()).To(MatchError(ContainSubstring(missing.String())))
+	})
+	It(`Retry failed requests with the settings of EnableRetries`, func() {
+		secretsManagerService.EnableRetries(2, 10*time.Millisecond)
+		failuresLeft.Store(2)
+
+		results, err := secretsManagerService.GetSecretsBatch(secretsManagerService.NewGetSecretsBatchOptions([]secretsmanagerv2.SecretReference{
+			secretsmanagerv2.SecretReferenceByID(secretIDs[0]),
+			secretsmanagerv2.SecretReferenceByID(secretIDs[1]),
+		}))
+		Expect(err).To(BeNil())
+		Expect(results.Err()).To(BeNil())
+	})
+	It(`Invoke GetSecretsBatch with error: invalid options`, func() {
+		results, err := secretsManagerService.GetSecretsBatch(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(results).To(BeNil())
+
+		results, err = secretsManagerService.GetSecretsBatch(secretsManagerService.NewGetSecretsBatchOptions(nil))
+		Expect(err).ToNot(BeNil())
+		Expect(results).To(BeNil())
+	})
+})
@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultSecretCacheTTL is the time-to-live that is used for cached secrets when
+// SecretCacheOptions.TTL is not set.
+const DefaultSecretCacheTTL = 5 * time.Minute
+
+// SecretCacheOptions : The options used to construct a SecretCache.
+type SecretCacheOptions struct {
+	// The time-to-live of a cached secret. If it is zero, DefaultSecretCacheTTL is used.
+	TTL time.Duration
+
+	// Time-to-live overrides keyed by secret type, for example "iam_credentials".
+	// A value that is not positive disables caching for that secret type.
+	TTLBySecretType map[string]time.Duration
+}
+
+// SecretCacheStats : A snapshot of the counters of a SecretCache.
+type SecretCacheStats struct {
+	// The number of lookups that were served from the cache.
+	Hits uint64
+
+	// The number of lookups that required a request to the service.
+	Misses uint64
+
+	// The number of entries that were dropped because they expired or were invalidated.
+	Evictions uint64
+
+	// The number of secrets that are currently cached.
+	Entries int
+}
+
+// SecretCache wraps a SecretsManagerV2 client and caches the secrets that are
+// returned by the "GetSecret" and "GetSecretByNameType" methods.
+//
+// A cached secret is served until the earliest of its time-to-live, its
+// expiration date, and its next rotation date. Cached values are shared
+// between callers and must not be modified.
+type SecretCache struct {
+	client          *SecretsManagerV2
+	ttl             time.Duration
+	ttlBySecretType map[string]time.Duration
+
+	mutex  sync.RWMutex
+	byID   map[string]*secretCacheEntry
+	byName map[secretCacheNameKey]*secretCacheEntry
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type secretCacheNameKey struct {
+	secretType      string
+	name            string
+	secretGroupName string
+}
+
+type secretCacheEntry struct {
+	secret    SecretIntf
+	id        string
+	expiresAt time.Time
+}
+
+// NewSecretCache returns a new SecretCache instance that uses "secretsManager"
+// to retrieve secrets that are not cached.
+func (secretsManager *SecretsManagerV2) NewSecretCache(options *SecretCacheOptions) (cache *SecretCache, err error) {
+	if options == nil {
+		options = &SecretCacheOptions{}
+	}
+	if options.TTL < 0 {
+		err = core.SDKErrorf(nil, "the 'options.TTL' field must not be negative", "invalid-cache-ttl", common.GetComponentInfo())
+		return
+	}
+
+	ttl := options.TTL
+	if ttl == 0 {
+		ttl = DefaultSecretCacheTTL
+	}
+	ttlBySecretType := make(map[string]time.Duration, len(options.TTLBySecretType))
+	for secretType, secretTypeTTL := range options.TTLBySecretType {
+		ttlBySecretType[secretType] = secretTypeTTL
+	}
+
+	cache = &SecretCache{
+		client:          secretsManager,
+		ttl:             ttl,
+		ttlBySecretType: ttlBySecretType,
+		byID:            make(map[string]*secretCacheEntry),
+		byName:          make(map[secretCacheNameKey]*secretCacheEntry),
+	}
+	return
+}
+
+// GetSecret returns the secret with the ID in "getSecretOptions", either from
+// the cache or by invoking the "GetSecret" method of the client.
+func (cache *SecretCache) GetSecret(getSecretOptions *GetSecretOptions) (result SecretIntf, err error) {
+	result, err = cache.GetSecretWithContext(context.Background(), getSecretOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretWithContext is an alternate form of the GetSecret method which supports a Context parameter
+func (cache *SecretCache) GetSecretWithContext(ctx context.Context, getSecretOptions *GetSecretOptions) (result SecretIntf, err error) {
+	err = core.ValidateNotNil(getSecretOptions, "getSecretOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSecretOptions, "getSecretOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	cache.mutex.RLock()
+	entry := cache.byID[*getSecretOptions.ID]
+	cache.mutex.RUnlock()
+	if result = cache.lookup(entry); result != nil {
+		return
+	}
+
+	result, _, err = cache.client.GetSecretWithContext(ctx, getSecretOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "cache-get-secret-error")
+		return
+	}
+	cache.store(result, nil)
+	return
+}
+
+// GetSecretByNameType returns the secret with the type, name and secret group
+// name in "getSecretByNameTypeOptions", either from the cache or by invoking
+// the "GetSecretByNameType" method of the client.
+func (cache *SecretCache) GetSecretByNameType(getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, err error) {
+	result, err = cache.GetSecretByNameTypeWithContext(context.Background(), getSecretByNameTypeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretByNameTypeWithContext is an alternate form of the GetSecretByNameType method which supports a Context parameter
+func (cache *SecretCache) GetSecretByNameTypeWithContext(ctx context.Context, getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, err error) {
+	err = core.ValidateNotNil(getSecretByNameTypeOptions, "getSecretByNameTypeOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getSecretByNameTypeOptions, "getSecretByNameTypeOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	key := secretCacheNameKey{
+		secretType:      *getSecretByNameTypeOptions.SecretType,
+		name:            *getSecretByNameTypeOptions.Name,
+		secretGroupName: *getSecretByNameTypeOptions.SecretGroupName,
+	}
+	cache.mutex.RLock()
+	entry := cache.byName[key]
+	cache.mutex.RUnlock()
+	if result = cache.lookup(entry); result != nil {
+		return
+	}
+
+	result, _, err = cache.client.GetSecretByNameTypeWithContext(ctx, getSecretByNameTypeOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "cache-get-secret-error")
+		return
+	}
+	cache.store(result, &key)
+	return
+}
+
+// Invalidate removes the secret with the specified ID from the cache, including
+// any entry that was cached by name for the same secret.
+func (cache *SecretCache) Invalidate(id string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if _, ok := cache.byID[id]; ok {
+		delete(cache.byID, id)
+		cache.evictions.Add(1)
+	}
+	for key, entry := range cache.byName {
+		if entry.id == id {
+			delete(cache.byName, key)
+		}
+	}
+}
+
+// InvalidateByNameType removes the secret with the specified type, name and
+// secret group name from the cache, including the entry that was cached by ID
+// for the same secret.
+func (cache *SecretCache) InvalidateByNameType(secretType string, name string, secretGroupName string) {
+	key := secretCacheNameKey{
+		secretType:      secretType,
+		name:            name,
+		secretGroupName: secretGroupName,
+	}
+
+	cache.mutex.RLock()
+	entry, ok := cache.byName[key]
+	cache.mutex.RUnlock()
+	if !ok {
+		return
+	}
+	cache.Invalidate(entry.id)
+}
+
+// Purge removes all secrets from the cache.
+func (cache *SecretCache) Purge() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.evictions.Add(uint64(len(cache.byID)))
+	cache.byID = make(map[string]*secretCacheEntry)
+	cache.byName = make(map[secretCacheNameKey]*secretCacheEntry)
+}
+
+// Stats returns a snapshot of the cache counters.
+func (cache *SecretCache) Stats() SecretCacheStats {
+	cache.mutex.RLock()
+	entries := len(cache.byID)
+	cache.mutex.RUnlock()
+
+	return SecretCacheStats{
+		Hits:      cache.hits.Load(),
+		Misses:    cache.misses.Load(),
+		Evictions: cache.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+// lookup returns the secret held by "entry" and records a hit, or records a
+// miss and drops the entry if it is missing or expired.
+func (cache *SecretCache) lookup(entry *secretCacheEntry) SecretIntf {
+	if entry != nil && time.Now().Before(entry.expiresAt) {
+		cache.hits.Add(1)
+		return entry.secret
+	}
+	cache.misses.Add(1)
+	if entry != nil {
+		cache.evict(entry)
+	}
+	return nil
+}
+
+// evict removes an expired entry from the cache. The entry is only removed
+// where it is still cached, so that an entry that was stored for the same
+// secret since the lookup is kept.
+func (cache *SecretCache) evict(entry *secretCacheEntry) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.byID[entry.id] == entry {
+		delete(cache.byID, entry.id)
+		cache.evictions.Add(1)
+	}
+	for key, cachedEntry := range cache.byName {
+		if cachedEntry == entry {
+			delete(cache.byName, key)
+		}
+	}
+}
+
+// store adds "secret" to the cache, indexed by its ID and, if "key" is not nil, by name.
+func (cache *SecretCache) store(secret SecretIntf, key *secretCacheNameKey) {
+	id := modelStringField(secret, "ID")
+	if id == "" {
+		return
+	}
+
+	ttl := cache.ttl
+	if secretTypeTTL, ok := cache.ttlBySecretType[modelStringField(secret, "SecretType")]; ok {
+		ttl = secretTypeTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	for _, fieldName := range []string{"ExpirationDate", "NextRotationDate"} {
+		if t := modelTimeField(secret, fieldName); !t.IsZero() && t.Before(expiresAt) {
+			expiresAt = t
+		}
+	}
+	if !time.Now().Before(expiresAt) {
+		return
+	}
+
+	entry := &secretCacheEntry{
+		secret:    secret,
+		id:        id,
+		expiresAt: expiresAt,
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.byID[id] = entry
+	for existingKey, existingEntry := range cache.byName {
+		if existingEntry.id == id {
+			cache.byName[existingKey] = entry
+		}
+	}
+	if key != nil {
+		cache.byName[*key] = entry
+	}
+}
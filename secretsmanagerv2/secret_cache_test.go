@@ -0,0 +1,160 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SecretCache`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	var expirationDate string
+	getSecretPath := "/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46"
+	getSecretByNameTypePath := "/api/v2/secret_groups/default/secret_types/arbitrary/secrets/my-secret"
+
+	newService := func() *secretsmanagerv2.SecretsManagerV2 {
+		secretsManagerService, serviceErr := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(secretsManagerService).ToNot(BeNil())
+		return secretsManagerService
+	}
+
+	BeforeEach(func() {
+		requestCount = 0
+		expirationDate = "2033-04-12T23:20:50.520Z"
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(BeElementOf(getSecretPath, getSecretByNameTypePath))
+			requestCount++
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "0b5571f7-21e6-42b7-91c5-3f5ac9793a46", "name": "my-secret", "secret_group_id": "default", "secret_type": "arbitrary", "expiration_date": "%s", "payload": "secret-credentials-%d"}`, expirationDate, requestCount)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Serve repeated GetSecret calls from the cache`, func() {
+		cache, err := newService().NewSecretCache(nil)
+		Expect(err).To(BeNil())
+
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+
+		for i := 0; i < 3; i++ {
+			result, err := cache.GetSecret(getSecretOptionsModel)
+			Expect(err).To(BeNil())
+			Expect(*result.(*secretsmanagerv2.ArbitrarySecret).Payload).To(Equal("secret-credentials-1"))
+		}
+		Expect(requestCount).To(Equal(1))
+
+		stats := cache.Stats()
+		Expect(stats.Hits).To(Equal(uint64(2)))
+		Expect(stats.Misses).To(Equal(uint64(1)))
+		Expect(stats.Entries).To(Equal(1))
+	})
+	It(`Share entries between GetSecretByNameType and GetSecret`, func() {
+		cache, err := newService().NewSecretCache(nil)
+		Expect(err).To(BeNil())
+
+		getSecretByNameTypeOptionsModel := new(secretsmanagerv2.GetSecretByNameTypeOptions)
+		getSecretByNameTypeOptionsModel.SecretType = core.StringPtr("arbitrary")
+		getSecretByNameTypeOptionsModel.Name = core.StringPtr("my-secret")
+		getSecretByNameTypeOptionsModel.SecretGroupName = core.StringPtr("default")
+
+		_, err = cache.GetSecretByNameType(getSecretByNameTypeOptionsModel)
+		Expect(err).To(BeNil())
+		_, err = cache.GetSecretByNameType(getSecretByNameTypeOptionsModel)
+		Expect(err).To(BeNil())
+
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+		_, err = cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(requestCount).To(Equal(1))
+
+		cache.InvalidateByNameType("arbitrary", "my-secret", "default")
+		Expect(cache.Stats().Entries).To(Equal(0))
+		_, err = cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(requestCount).To(Equal(2))
+	})
+	It(`Do not cache secrets that are already expired`, func() {
+		expirationDate = "2020-04-12T23:20:50.520Z"
+		cache, err := newService().NewSecretCache(nil)
+		Expect(err).To(BeNil())
+
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+		_, err = cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		_, err = cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(requestCount).To(Equal(2))
+		Expect(cache.Stats().Misses).To(Equal(uint64(2)))
+	})
+	It(`Apply per-type TTLs`, func() {
+		cache, err := newService().NewSecretCache(&secretsmanagerv2.SecretCacheOptions{
+			TTLBySecretType: map[string]time.Duration{"arbitrary": 50 * time.Millisecond},
+		})
+		Expect(err).To(BeNil())
+
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+		_, err = cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		time.Sleep(100 * time.Millisecond)
+		result, err := cache.GetSecret(getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(*result.(*secretsmanagerv2.ArbitrarySecret).Payload).To(Equal("secret-credentials-2"))
+		Expect(cache.Stats().Evictions).To(Equal(uint64(1)))
+	})
+	It(`Invoke NewSecretCache with error: negative TTL`, func() {
+		cache, err := newService().NewSecretCache(&secretsmanagerv2.SecretCacheOptions{TTL: -time.Second})
+		Expect(err).ToNot(BeNil())
+		Expect(cache).To(BeNil())
+	})
+	It(`Invoke SecretCache.GetSecret with error: invalid options`, func() {
+		cache, err := newService().NewSecretCache(nil)
+		Expect(err).To(BeNil())
+
+		result, err := cache.GetSecret(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		result, err = cache.GetSecret(new(secretsmanagerv2.GetSecretOptions))
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+		Expect(requestCount).To(Equal(0))
+	})
+})
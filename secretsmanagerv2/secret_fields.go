@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// The generated secret, secret version and metadata models share most of their
+// properties but do not expose them through their interfaces. The functions in
+// this file read those common properties by field name so that hand-written
+// helpers do not need a type switch over every model.
+
+// modelField returns the named field of a model struct pointer. The boolean
+// result is false if the model is nil, does not define the field, or the
+// field is a nil pointer.
+func modelField(model interface{}, fieldName string) (field reflect.Value, ok bool) {
+	value := reflect.ValueOf(model)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return
+	}
+	value = value.Elem()
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	field = value.FieldByName(fieldName)
+	if !field.IsValid() {
+		return
+	}
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		return
+	}
+	ok = true
+	return
+}
+
+// modelStringField returns the value of the named *string field of a model,
+// or an empty string if it is not set.
+func modelStringField(model interface{}, fieldName string) string {
+	field, ok := modelField(model, fieldName)
+	if !ok {
+		return ""
+	}
+	if s, isString := field.Interface().(*string); isString {
+		return *s
+	}
+	return ""
+}
+
+// modelInt64Field returns the value of the named *int64 field of a model,
+// or nil if it is not set.
+func modelInt64Field(model interface{}, fieldName string) *int64 {
+	field, ok := modelField(model, fieldName)
+	if !ok {
+		return nil
+	}
+	if i, isInt := field.Interface().(*int64); isInt {
+		return i
+	}
+	return nil
+}
+
+// modelTimeField returns the value of the named *strfmt.DateTime field of a
+// model, or the zero time if it is not set.
+func modelTimeField(model interface{}, fieldName string) time.Time {
+	field, ok := modelField(model, fieldName)
+	if !ok {
+		return time.Time{}
+	}
+	if dt, isDateTime := field.Interface().(*strfmt.DateTime); isDateTime {
+		return time.Time(*dt)
+	}
+	return time.Time{}
+}
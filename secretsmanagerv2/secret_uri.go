@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// SecretURIScheme is the scheme of secret URIs.
+const SecretURIScheme = "ibmsm"
+
+// SecretURI references a field of a secret, or of one of its versions, by the
+// name of its secret group, its type and its name:
+//
+//	ibmsm://<secret_group_name>/<secret_type>/<name>?version=previous&field=password
+//
+// The "version" query parameter is "current", "previous" or the ID of a
+// version, and defaults to the current version. The "field" query parameter
+// is the name of a field of the secret, or a key of the data of a "kv" secret
+// or of the credentials of a "custom_credentials" or "service_credentials"
+// secret. It defaults to the main field of the secret type: "payload",
+// "password", "api_key", "certificate" or "apikey". It is required for "kv"
+// and "custom_credentials" secrets.
+type SecretURI struct {
+	// The name of the secret group.
+	SecretGroupName string
+
+	// The secret type.
+	SecretType string
+
+	// The name of the secret.
+	Name string
+
+	// "current", "previous" or the ID of a version. The current version is used if it is empty.
+	Version string
+
+	// The field to extract. The default field of the secret type is used if it is empty.
+	Field string
+}
+
+// secretURIFields maps the fields that can be extracted from a secret to the
+// names of the model properties that hold them.
+var secretURIFields = map[string]string{
+	"payload":      "Payload",
+	"username":     "Username",
+	"password":     "Password",
+	"api_key":      "ApiKey",
+	"certificate":  "Certificate",
+	"intermediate": "Intermediate",
+	"private_key":  "PrivateKey",
+	"issuing_ca":   "IssuingCa",
+	"ca_chain":     "CaChain",
+}
+
+// secretURIDefaultFields maps the secret types to their default fields.
+var secretURIDefaultFields = map[string]string{
+	Secret_SecretType_Arbitrary:          "payload",
+	Secret_SecretType_IamCredentials:     "api_key",
+	Secret_SecretType_ImportedCert:       "certificate",
+	Secret_SecretType_PrivateCert:        "certificate",
+	Secret_SecretType_PublicCert:         "certificate",
+	Secret_SecretType_ServiceCredentials: "apikey",
+	Secret_SecretType_UsernamePassword:   "password",
+}
+
+// ParseSecretURI parses a secret URI of the form
+// "ibmsm://<secret_group_name>/<secret_type>/<name>?version=<version>&field=<field>".
+func ParseSecretURI(rawURI string) (secretURI *SecretURI, err error) {
+	parsed, err := url.Parse(rawURI)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-secret-uri", common.GetComponentInfo())
+		return
+	}
+	if parsed.Scheme != SecretURIScheme {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the secret URI '%s' must use the scheme '%s'", rawURI, SecretURIScheme), "invalid-secret-uri", common.GetComponentInfo())
+		return
+	}
+	segments := strings.Split(strings.TrimPrefix(parsed.Path, "/"), "/")
+	if parsed.Host == "" || len(segments) != 2 || segments[0] == "" || segments[1] == "" || parsed.User != nil || parsed.Fragment != "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the secret URI '%s' must have the form '%s://<secret_group_name>/<secret_type>/<name>'", rawURI, SecretURIScheme), "invalid-secret-uri", common.GetComponentInfo())
+		return
+	}
+
+	query, err := url.ParseQuery(parsed.RawQuery)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-secret-uri", common.GetComponentInfo())
+		return
+	}
+	for key, values := range query {
+		if (key != "version" && key != "field") || len(values) != 1 || values[0] == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the secret URI '%s' has an invalid query parameter '%s'", rawURI, key), "invalid-secret-uri", common.GetComponentInfo())
+			return
+		}
+	}
+
+	secretURI = &SecretURI{
+		SecretGroupName: parsed.Host,
+		SecretType:      segments[0],
+		Name:            segments[1],
+		Version:         query.Get("version"),
+		Field:           query.Get("field"),
+	}
+	return
+}
+
+// String returns the URI form of the secret URI.
+func (secretURI *SecretURI) String() string {
+	query := url.Values{}
+	if secretURI.Version != "" {
+		query.Set("version", secretURI.Version)
+	}
+	if secretURI.Field != "" {
+		query.Set("field", secretURI.Field)
+	}
+	uri := url.URL{
+		Scheme:   SecretURIScheme,
+		Host:     secretURI.SecretGroupName,
+		Path:     "/" + secretURI.SecretType + "/" + secretURI.Name,
+		RawQuery: query.Encode(),
+	}
+	return uri.String()
+}
+
+// field returns the field that is extracted for the secret URI.
+func (secretURI *SecretURI) field() (field string, err error) {
+	field = secretURI.Field
+	if field == "" {
+		field = secretURIDefaultFields[secretURI.SecretType]
+	}
+	if field == "" {
+		err = fmt.Errorf("the secret URI '%s' must specify a field for secrets of type '%s'", secretURI, secretURI.SecretType)
+	}
+	return
+}
+
+// ResolveSecretURIOptions : The ResolveSecretURI options.
+type ResolveSecretURIOptions struct {
+	// The secret URI to resolve, for example "ibmsm://default/username_password/db?field=username".
+	URI string `validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewResolveSecretURIOptions : Instantiate ResolveSecretURIOptions
+func (*SecretsManagerV2) NewResolveSecretURIOptions(uri string) *ResolveSecretURIOptions {
+	return &ResolveSecretURIOptions{
+		URI: uri,
+	}
+}
+
+// SetURI : Allow user to set URI
+func (options *ResolveSecretURIOptions) SetURI(uri string) *ResolveSecretURIOptions {
+	options.URI = uri
+	return options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ResolveSecretURIOptions) SetHeaders(param map[string]string) *ResolveSecretURIOptions {
+	options.Headers = param
+	return options
+}
+
+// ResolveSecretURI : Get a field of a secret by its URI
+// Parse the secret URI in "resolveSecretURIOptions.URI", retrieve the secret with
+// GetSecretByNameType, or the requested version of the secret with
+// GetSecretVersion, and return the requested field as a string. Fields that
+// are not strings, such as nested KV data, are returned as JSON. The "ca_chain"
+// field of a private certificate is returned as concatenated PEM blocks.
+func (secretsManager *SecretsManagerV2) ResolveSecretURI(resolveSecretURIOptions *ResolveSecretURIOptions) (result string, response *core.DetailedResponse, err error) {
+	result, response, err = secretsManager.ResolveSecretURIWithContext(context.Background(), resolveSecretURIOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolveSecretURIWithContext is an alternate form of the ResolveSecretURI method which supports a Context parameter
+func (secretsManager *SecretsManagerV2) ResolveSecretURIWithContext(ctx context.Context, resolveSecretURIOptions *ResolveSecretURIOptions) (result string, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(resolveSecretURIOptions, "resolveSecretURIOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(resolveSecretURIOptions, "resolveSecretURIOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	secretURI, err := ParseSecretURI(resolveSecretURIOptions.URI)
+	if err != nil {
+		return
+	}
+	field, err := secretURI.field()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-secret-uri", common.GetComponentInfo())
+		return
+	}
+
+	getSecretByNameTypeOptions := secretsManager.NewGetSecretByNameTypeOptions(secretURI.SecretType, secretURI.Name, secretURI.SecretGroupName)
+	getSecretByNameTypeOptions.Headers = resolveSecretURIOptions.Headers
+	secret, response, err := secretsManager.GetSecretByNameTypeWithContext(ctx, getSecretByNameTypeOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "resolve-secret-uri-error")
+		return
+	}
+	var model interface{} = secret
+	if secretURI.Version != "" && secretURI.Version != "current" {
+		getSecretVersionOptions := secretsManager.NewGetSecretVersionOptions(modelStringField(secret, "ID"), secretURI.Version)
+		getSecretVersionOptions.Headers = resolveSecretURIOptions.Headers
+		model, response, err = secretsManager.GetSecretVersionWithContext(ctx, getSecretVersionOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "resolve-secret-uri-error")
+			return
+		}
+	}
+
+	result, err = secretURIFieldValue(model, secretURI.SecretType, field)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("the secret URI '%s' cannot be resolved: %s", secretURI, err.Error()), "secret-uri-field-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// secretURIFieldValue returns the value of a field of a secret or secret version model.
+func secretURIFieldValue(model interface{}, secretType string, field string) (string, error) {
+	contentFieldName := ""
+	switch secretType {
+	case Secret_SecretType_Kv:
+		contentFieldName = "Data"
+	case Secret_SecretType_CustomCredentials:
+		contentFieldName = "CredentialsContent"
+	case Secret_SecretType_ServiceCredentials:
+		contentFieldName = "Credentials"
+	}
+	if contentFieldName != "" {
+		content, ok := modelField(model, contentFieldName)
+		if !ok {
+			return "", fmt.Errorf("the field '%s' is not set", field)
+		}
+		return secretURIContentValue(content.Interface(), field)
+	}
+
+	fieldName, ok := secretURIFields[field]
+	if !ok {
+		return "", fmt.Errorf("secrets of type '%s' have no field '%s'", secretType, field)
+	}
+	value, ok := modelField(model, fieldName)
+	if !ok {
+		return "", fmt.Errorf("the field '%s' is not set", field)
+	}
+	switch v := value.Interface().(type) {
+	case *string:
+		return *v, nil
+	case []string:
+		return strings.Join(v, "\n"), nil
+	}
+	return "", fmt.Errorf("the field '%s' is not a string", field)
+}
+
+// secretURIContentValue returns the value of a key of the data or credentials
+// of a secret. Values that are not strings are returned as JSON.
+func secretURIContentValue(content interface{}, key string) (string, error) {
+	// The credentials of service credentials secrets are a struct with
+	// additional properties, which are flattened by their JSON form.
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	var data map[string]interface{}
+	if err = json.Unmarshal(encoded, &data); err != nil {
+		return "", err
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("the key '%s' is not set", key)
+	}
+	if s, isString := value.(string); isString {
+		return s, nil
+	}
+	encoded, err = json.Marshal(value)
+	return string(encoded), err
+}
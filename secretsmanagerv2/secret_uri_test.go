@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secret URIs`, func() {
+	Describe(`ParseSecretURI`, func() {
+		It(`Parse a secret URI`, func() {
+			secretURI, err := secretsmanagerv2.ParseSecretURI("ibmsm://my-group/username_password/db?version=previous&field=password")
+			Expect(err).To(BeNil())
+			Expect(*secretURI).To(Equal(secretsmanagerv2.SecretURI{
+				SecretGroupName: "my-group",
+				SecretType:      secretsmanagerv2.Secret_SecretType_UsernamePassword,
+				Name:            "db",
+				Version:         "previous",
+				Field:           "password",
+			}))
+			Expect(secretURI.String()).To(Equal("ibmsm://my-group/username_password/db?field=password&version=previous"))
+
+			secretURI, err = secretsmanagerv2.ParseSecretURI("ibmsm://default/arbitrary/token")
+			Expect(err).To(BeNil())
+			Expect(secretURI.Version).To(BeEmpty())
+			Expect(secretURI.Field).To(BeEmpty())
+			Expect(secretURI.String()).To(Equal("ibmsm://default/arbitrary/token"))
+		})
+		It(`Invoke ParseSecretURI with error: invalid URIs`, func() {
+			for _, uri := range []string{
+				"https://default/arbitrary/token",
+				"ibmsm://default/arbitrary",
+				"ibmsm://default/arbitrary/token/extra",
+				"ibmsm:///arbitrary/token",
+				"ibmsm://default/arbitrary/token?unknown=1",
+				"ibmsm://default/arbitrary/token?field=a&field=b",
+				"ibmsm://default/arbitrary/token?field=",
+				"ibmsm://default/arbitrary/token#payload",
+				"ibmsm://user@default/arbitrary/token",
+			} {
+				_, err := secretsmanagerv2.ParseSecretURI(uri)
+				Expect(err).ToNot(BeNil(), uri)
+			}
+		})
+	})
+
+	Describe(`ResolveSecretURI`, func() {
+		var server *smtest.Server
+		var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+
+		resolve := func(uri string) (string, error) {
+			result, _, err := secretsManagerService.ResolveSecretURI(secretsManagerService.NewResolveSecretURIOptions(uri))
+			return result, err
+		}
+
+		BeforeEach(func() {
+			server = smtest.NewServer()
+			var err error
+			secretsManagerService, err = server.NewClient()
+			Expect(err).To(BeNil())
+
+			usernamePasswordPrototype, err := secretsManagerService.NewUsernamePasswordSecretPrototype(secretsmanagerv2.Secret_SecretType_UsernamePassword, "db", "admin")
+			Expect(err).To(BeNil())
+			usernamePasswordPrototype.Password = core.StringPtr("first-password")
+			secret, _, err := secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(usernamePasswordPrototype))
+			Expect(err).To(BeNil())
+			versionPrototype := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{Password: core.StringPtr("second-password")}
+			_, _, err = secretsManagerService.CreateSecretVersion(secretsManagerService.NewCreateSecretVersionOptions(*secret.(*secretsmanagerv2.UsernamePasswordSecret).ID, versionPrototype))
+			Expect(err).To(BeNil())
+
+			kvPrototype, err := secretsManagerService.NewKVSecretPrototype(secretsmanagerv2.Secret_SecretType_Kv, "config", map[string]interface{}{
+				"host":  "db.example.com",
+				"ports": []interface{}{5432, 5433},
+			})
+			Expect(err).To(BeNil())
+			_, _, err = secretsManagerService.CreateSecret(secretsManagerService.NewCreateSecretOptions(kvPrototype))
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Resolve the fields of secrets and versions`, func() {
+			Expect(resolve("ibmsm://default/username_password/db")).To(Equal("second-password"))
+			Expect(resolve("ibmsm://default/username_password/db?field=username")).To(Equal("admin"))
+			Expect(resolve("ibmsm://default/username_password/db?version=current&field=password")).To(Equal("second-password"))
+			Expect(resolve("ibmsm://default/username_password/db?version=previous&field=password")).To(Equal("first-password"))
+			Expect(resolve("ibmsm://default/kv/config?field=host")).To(Equal("db.example.com"))
+			Expect(resolve("ibmsm://default/kv/config?field=ports")).To(Equal("[5432,5433]"))
+		})
+		It(`Invoke ResolveSecretURI with error: field not found`, func() {
+			_, err := resolve("ibmsm://default/username_password/db?field=api_key")
+			Expect(err).To(MatchError(ContainSubstring("cannot be resolved")))
+			_, err = resolve("ibmsm://default/kv/config?field=missing")
+			Expect(err).To(MatchError(ContainSubstring("the key 'missing' is not set")))
+			_, err = resolve("ibmsm://default/kv/config")
+			Expect(err).To(MatchError(ContainSubstring("must specify a field")))
+		})
+		It(`Invoke ResolveSecretURI with error: secret not found`, func() {
+			_, response, err := secretsManagerService.ResolveSecretURI(secretsManagerService.NewResolveSecretURIOptions("ibmsm://default/arbitrary/missing"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		})
+		It(`Invoke ResolveSecretURI with error: invalid options`, func() {
+			_, _, err := secretsManagerService.ResolveSecretURI(nil)
+			Expect(err).ToNot(BeNil())
+			_, _, err = secretsManagerService.ResolveSecretURI(secretsManagerService.NewResolveSecretURIOptions(""))
+			Expect(err).ToNot(BeNil())
+			_, _, err = secretsManagerService.ResolveSecretURI(secretsManagerService.NewResolveSecretURIOptions("ibmsm://default"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
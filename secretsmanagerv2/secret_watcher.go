@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// DefaultSecretWatcherInterval is the polling interval that is used when
+// SecretWatcherOptions.Interval is not set.
+const DefaultSecretWatcherInterval = time.Minute
+
+// DefaultSecretWatcherMaxBackoff is the longest delay between polls of a failing
+// secret that is used when SecretWatcherOptions.MaxBackoff is not set.
+const DefaultSecretWatcherMaxBackoff = 10 * time.Minute
+
+// SecretWatcherOptions : The options used to construct a SecretWatcher.
+type SecretWatcherOptions struct {
+	// The IDs of the secrets to watch.
+	SecretIDs []string `validate:"required,min=1,dive,ne="`
+
+	// The time between two polls of the same secret. If it is zero, DefaultSecretWatcherInterval is used.
+	Interval time.Duration
+
+	// The longest delay between polls of a secret whose previous polls failed. If it is zero,
+	// DefaultSecretWatcherMaxBackoff is used.
+	MaxBackoff time.Duration
+
+	// The function that receives new secret versions. If it is nil, updates are delivered
+	// through the channel returned by SecretWatcher.Updates().
+	OnUpdate func(update *SecretUpdate)
+
+	// The function that receives polling errors. Errors are dropped if it is nil.
+	OnError func(secretID string, err error)
+}
+
+// SecretUpdate : A new version of a watched secret.
+type SecretUpdate struct {
+	// The ID of the secret.
+	SecretID string
+
+	// The ID of the version that is now the current version of the secret.
+	VersionID string
+
+	// The secret, including the payload of the current version.
+	Secret SecretIntf
+}
+
+// SecretWatcher polls a set of secrets and delivers their payload each time a
+// new current version is detected.
+//
+// The metadata of each secret is polled with "GetSecretMetadata". When its
+// version count or update date changes, "ListSecretVersions" is used to
+// identify the current version and, if that version is new, the payload is
+// retrieved with "GetSecret". The first successful poll of every secret
+// always delivers its current version.
+type SecretWatcher struct {
+	client     *SecretsManagerV2
+	secretIDs  []string
+	interval   time.Duration
+	maxBackoff time.Duration
+	onUpdate   func(update *SecretUpdate)
+	onError    func(secretID string, err error)
+	updates    chan *SecretUpdate
+	running    atomic.Bool
+}
+
+type secretWatchState struct {
+	polled        bool
+	versionsTotal int64
+	updatedAt     time.Time
+	versionID     string
+	failures      int
+	nextPoll      time.Time
+}
+
+// NewSecretWatcher returns a new SecretWatcher instance.
+func (secretsManager *SecretsManagerV2) NewSecretWatcher(options *SecretWatcherOptions) (watcher *SecretWatcher, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if options.Interval < 0 || options.MaxBackoff < 0 {
+		err = core.SDKErrorf(nil, "the 'options.Interval' and 'options.MaxBackoff' fields must not be negative", "invalid-watch-interval", common.GetComponentInfo())
+		return
+	}
+
+	watcher = &SecretWatcher{
+		client:     secretsManager,
+		secretIDs:  append([]string(nil), options.SecretIDs...),
+		interval:   options.Interval,
+		maxBackoff: options.MaxBackoff,
+		onUpdate:   options.OnUpdate,
+		onError:    options.OnError,
+		updates:    make(chan *SecretUpdate, len(options.SecretIDs)),
+	}
+	if watcher.interval == 0 {
+		watcher.interval = DefaultSecretWatcherInterval
+	}
+	if watcher.maxBackoff == 0 {
+		watcher.maxBackoff = DefaultSecretWatcherMaxBackoff
+	}
+	if watcher.maxBackoff < watcher.interval {
+		watcher.maxBackoff = watcher.interval
+	}
+	return
+}
+
+// Updates returns the channel on which new secret versions are delivered when
+// no SecretWatcherOptions.OnUpdate function is configured. The channel is
+// closed when Run returns.
+func (watcher *SecretWatcher) Updates() <-chan *SecretUpdate {
+	return watcher.updates
+}
+
+// Run polls the watched secrets until "ctx" is cancelled, and then returns the
+// error of the context. Run may only be invoked once for each SecretWatcher.
+func (watcher *SecretWatcher) Run(ctx context.Context) (err error) {
+	if !watcher.running.CompareAndSwap(false, true) {
+		err = core.SDKErrorf(nil, "the watcher has already been started", "watcher-already-started", common.GetComponentInfo())
+		return
+	}
+	defer close(watcher.updates)
+
+	states := make(map[string]*secretWatchState, len(watcher.secretIDs))
+	for _, secretID := range watcher.secretIDs {
+		states[secretID] = &secretWatchState{}
+	}
+
+	for {
+		now := time.Now()
+		nextPoll := now.Add(watcher.interval)
+		for _, secretID := range watcher.secretIDs {
+			state := states[secretID]
+			if state.nextPoll.After(now) {
+				if state.nextPoll.Before(nextPoll) {
+					nextPoll = state.nextPoll
+				}
+				continue
+			}
+
+			update, pollErr := watcher.poll(ctx, secretID, state)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if pollErr != nil {
+				state.failures++
+				if watcher.onError != nil {
+					watcher.onError(secretID, pollErr)
+				}
+			} else {
+				state.failures = 0
+			}
+			state.nextPoll = time.Now().Add(watcher.delay(state.failures))
+			if state.nextPoll.Before(nextPoll) {
+				nextPoll = state.nextPoll
+			}
+
+			if update != nil && !watcher.deliver(ctx, update) {
+				return ctx.Err()
+			}
+		}
+
+		timer := time.NewTimer(time.Until(nextPoll))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// poll checks a single secret for a new current version and returns an update
+// if one was found.
+func (watcher *SecretWatcher) poll(ctx context.Context, secretID string, state *secretWatchState) (update *SecretUpdate, err error) {
+	metadata, _, err := watcher.client.GetSecretMetadataWithContext(ctx, &GetSecretMetadataOptions{
+		ID: &secretID,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "watch-metadata-error")
+		return
+	}
+
+	var versionsTotal int64
+	if total := modelInt64Field(metadata, "VersionsTotal"); total != nil {
+		versionsTotal = *total
+	}
+	updatedAt := modelTimeField(metadata, "UpdatedAt")
+	if state.polled && versionsTotal == state.versionsTotal && updatedAt.Equal(state.updatedAt) {
+		return
+	}
+
+	versions, _, err := watcher.client.ListSecretVersionsWithContext(ctx, &ListSecretVersionsOptions{
+		SecretID: &secretID,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "watch-versions-error")
+		return
+	}
+	var versionID string
+	for _, version := range versions.Versions {
+		if modelStringField(version, "Alias") == SecretVersionMetadata_Alias_Current {
+			versionID = modelStringField(version, "ID")
+			break
+		}
+	}
+	if versionID == "" {
+		err = core.SDKErrorf(nil, "the current version of secret '"+secretID+"' was not found", "watch-current-version-error", common.GetComponentInfo())
+		return
+	}
+
+	if !state.polled || versionID != state.versionID {
+		var secret SecretIntf
+		secret, _, err = watcher.client.GetSecretWithContext(ctx, &GetSecretOptions{
+			ID: &secretID,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "watch-get-secret-error")
+			return
+		}
+		update = &SecretUpdate{
+			SecretID:  secretID,
+			VersionID: versionID,
+			Secret:    secret,
+		}
+	}
+
+	state.polled = true
+	state.versionsTotal = versionsTotal
+	state.updatedAt = updatedAt
+	state.versionID = versionID
+	return
+}
+
+// deliver hands "update" to the configured callback or channel. It returns
+// false if "ctx" was cancelled before the update could be delivered.
+func (watcher *SecretWatcher) deliver(ctx context.Context, update *SecretUpdate) bool {
+	if watcher.onUpdate != nil {
+		watcher.onUpdate(update)
+		return true
+	}
+	select {
+	case watcher.updates <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// delay returns the jittered time to wait before the next poll of a secret
+// whose last "failures" polls failed. The delay doubles with each failure up
+// to the configured maximum backoff.
+func (watcher *SecretWatcher) delay(failures int) time.Duration {
+	delay := watcher.interval
+	for i := 0; i < failures && delay < watcher.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > watcher.maxBackoff {
+		delay = watcher.maxBackoff
+	}
+	// Spread polls over the last 20% of the delay to avoid synchronized bursts.
+	return delay - time.Duration(rand.Int64N(int64(delay)/5+1))
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SecretWatcher`, func() {
+	var testServer *httptest.Server
+	var version atomic.Int64
+	var failMetadata atomic.Bool
+	secretID := "0b5571f7-21e6-42b7-91c5-3f5ac9793a46"
+
+	newService := func() *secretsmanagerv2.SecretsManagerV2 {
+		secretsManagerService, serviceErr := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(secretsManagerService).ToNot(BeNil())
+		return secretsManagerService
+	}
+
+	BeforeEach(func() {
+		version.Store(1)
+		failMetadata.Store(false)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			current := version.Load()
+			switch req.URL.EscapedPath() {
+			case "/api/v2/secrets/" + secretID + "/metadata":
+				if failMetadata.Load() {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"message": "internal error"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "name": "my-secret", "secret_group_id": "default", "secret_type": "username_password", "versions_total": %d, "updated_at": "2022-04-12T23:20:5%d.520Z"}`, secretID, current, current)
+			case "/api/v2/secrets/" + secretID + "/versions":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"versions": [{"id": "version-%d", "alias": "current", "secret_type": "username_password"}, {"id": "version-%d", "alias": "previous", "secret_type": "username_password"}], "total_count": 2}`, current, current-1)
+			case "/api/v2/secrets/" + secretID:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "name": "my-secret", "secret_group_id": "default", "secret_type": "username_password", "username": "user", "password": "password-%d"}`, secretID, current)
+			default:
+				Fail("unexpected request path " + req.URL.EscapedPath())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Deliver the current version and then each new version on the channel`, func() {
+		watcher, err := newService().NewSecretWatcher(&secretsmanagerv2.SecretWatcherOptions{
+			SecretIDs: []string{secretID},
+			Interval:  20 * time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- watcher.Run(ctx)
+		}()
+
+		var update *secretsmanagerv2.SecretUpdate
+		Eventually(watcher.Updates()).Should(Receive(&update))
+		Expect(update.SecretID).To(Equal(secretID))
+		Expect(update.VersionID).To(Equal("version-1"))
+		Expect(*update.Secret.(*secretsmanagerv2.UsernamePasswordSecret).Password).To(Equal("password-1"))
+
+		Consistently(watcher.Updates(), 100*time.Millisecond).ShouldNot(Receive())
+
+		version.Store(2)
+		Eventually(watcher.Updates()).Should(Receive(&update))
+		Expect(update.VersionID).To(Equal("version-2"))
+		Expect(*update.Secret.(*secretsmanagerv2.UsernamePasswordSecret).Password).To(Equal("password-2"))
+
+		cancelFunc()
+		Eventually(done).Should(Receive(Equal(context.Canceled)))
+		Eventually(watcher.Updates()).Should(BeClosed())
+
+		err = watcher.Run(context.Background())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Report polling errors and recover`, func() {
+		failMetadata.Store(true)
+
+		var mutex sync.Mutex
+		var updates []*secretsmanagerv2.SecretUpdate
+		var errorCount atomic.Int64
+		watcher, err := newService().NewSecretWatcher(&secretsmanagerv2.SecretWatcherOptions{
+			SecretIDs:  []string{secretID},
+			Interval:   10 * time.Millisecond,
+			MaxBackoff: 40 * time.Millisecond,
+			OnUpdate: func(update *secretsmanagerv2.SecretUpdate) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				updates = append(updates, update)
+			},
+			OnError: func(id string, err error) {
+				defer GinkgoRecover()
+				Expect(id).To(Equal(secretID))
+				Expect(err).ToNot(BeNil())
+				errorCount.Add(1)
+			},
+		})
+		Expect(err).To(BeNil())
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+		go watcher.Run(ctx)
+
+		Eventually(errorCount.Load).Should(BeNumerically(">=", 2))
+		failMetadata.Store(false)
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(updates)
+		}).Should(Equal(1))
+	})
+	It(`Invoke NewSecretWatcher with error: invalid options`, func() {
+		watcher, err := newService().NewSecretWatcher(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(watcher).To(BeNil())
+
+		watcher, err = newService().NewSecretWatcher(&secretsmanagerv2.SecretWatcherOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(watcher).To(BeNil())
+
+		watcher, err = newService().NewSecretWatcher(&secretsmanagerv2.SecretWatcherOptions{
+			SecretIDs: []string{secretID},
+			Interval:  -time.Second,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(watcher).To(BeNil())
+	})
+})
@@ -43,6 +43,12 @@ import (
 // See: https://cloud.ibm.com/docs/secrets-manager
 type SecretsManagerV2 struct {
 	Service *core.BaseService
+
+	// The transport that applies the request limits of the client, or nil.
+	transport *clientTransport
+
+	// The interceptor chain of the operations of the client.
+	interceptors []Interceptor
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -63,6 +69,36 @@ type SecretsManagerV2Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// The ID or the CRN of the instance. If it is set and URL is not, the
+	// service URL is the endpoint of the instance of type EndpointType in Region.
+	InstanceID string
+
+	// The region of the instance. It can be empty if InstanceID is a CRN.
+	Region string
+
+	// The type of endpoint of the instance: EndpointTypePublic (the default),
+	// EndpointTypePrivate or EndpointTypeDirect.
+	EndpointType string
+
+	// The client-side rate limit of all the requests of the client. If it is
+	// nil, the requests are not rate limited.
+	RateLimit *RateLimit
+
+	// The client-side rate limits of the requests of individual operations,
+	// by operation name such as "GetSecret". They apply in addition to
+	// RateLimit.
+	OperationRateLimits map[string]RateLimit
+
+	// The circuit breaker of the client. If it is nil, the client has none.
+	//
+	// The rate limits and the circuit breaker wrap the transport of the HTTP
+	// client of the service when the client is constructed, and apply to each
+	// attempt of automatic retries. Replacing the HTTP client with
+	// Service.SetHTTPClient removes them. Use DisableSSLVerification rather
+	// than Service.DisableSSLVerification, which has no effect on the wrapped
+	// transport.
+	CircuitBreaker *CircuitBreakerOptions
 }
 
 // NewSecretsManagerV2UsingExternalConfig : constructs an instance of SecretsManagerV2 with passed in options and external configuration.
@@ -79,7 +115,10 @@ func NewSecretsManagerV2UsingExternalConfig(options *SecretsManagerV2Options) (s
 		}
 	}
 
-	secretsManager, err = NewSecretsManagerV2(options)
+	// The request limits wrap the HTTP client that the external configuration sets up.
+	serviceOptions := *options
+	serviceOptions.RateLimit, serviceOptions.OperationRateLimits, serviceOptions.CircuitBreaker = nil, nil, nil
+	secretsManager, err = NewSecretsManagerV2(&serviceOptions)
 	err = core.RepurposeSDKProblem(err, "new-client-error")
 	if err != nil {
 		return
@@ -91,6 +130,11 @@ func NewSecretsManagerV2UsingExternalConfig(options *SecretsManagerV2Options) (s
 		return
 	}
 
+	err = secretsManager.installRequestLimits(options)
+	if err != nil {
+		return
+	}
+
 	if options.URL != "" {
 		err = secretsManager.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
@@ -111,8 +155,16 @@ func NewSecretsManagerV2(options *SecretsManagerV2Options) (service *SecretsMana
 		return
 	}
 
-	if options.URL != "" {
-		err = baseService.SetServiceURL(options.URL)
+	serviceURL := options.URL
+	if serviceURL == "" && options.InstanceID != "" {
+		serviceURL, err = GetServiceURLForInstance(options.InstanceID, options.Region, options.EndpointType)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "instance-url-error")
+			return
+		}
+	}
+	if serviceURL != "" {
+		err = baseService.SetServiceURL(serviceURL)
 		if err != nil {
 			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
 			return
@@ -123,12 +175,34 @@ func NewSecretsManagerV2(options *SecretsManagerV2Options) (service *SecretsMana
 		Service: baseService,
 	}
 
+	err = service.installRequestLimits(options)
+	if err != nil {
+		service = nil
+	}
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
+// installRequestLimits wraps the transport of the HTTP client of the service
+// with the request limits of "options", if there are any.
+func (secretsManager *SecretsManagerV2) installRequestLimits(options *SecretsManagerV2Options) error {
+	limits, err := newRequestLimits(options)
+	if err != nil || limits == nil {
+		return err
+	}
+	secretsManager.transport = &clientTransport{limits: limits}
+	installTransport(secretsManager.Service, secretsManager.transport)
+	return nil
+}
+
+// GetServiceURLForRegion returns the service URL to be used for the specified region.
+// The URL of a Secrets Manager instance also depends on the ID of the instance,
+// so the service does not support regional URLs: an error is returned for every
+// region. Use GetServiceURLForInstance to build the URL of an instance.
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	if err := validateRegion(region); err != nil {
+		return "", err
+	}
+	return "", core.SDKErrorf(nil, fmt.Sprintf("service does not support regional URLs; use GetServiceURLForInstance to build the URL of an instance in the region '%s'", region), "no-regional-support", common.GetComponentInfo())
 }
 
 // Clone makes a copy of "secretsManager" suitable for processing requests.
@@ -142,8 +216,20 @@ func (secretsManager *SecretsManagerV2) Clone() *SecretsManagerV2 {
 }
 
 // ConstructServiceURL constructs a service URL from the parameterized URL.
+// The "endpoint_type" variable selects the parameterized URL of the public
+// (the default), private or direct endpoint.
 func ConstructServiceURL(providedUrlVariables map[string]string) (string, error) {
-	return core.ConstructServiceURL(ParameterizedServiceURL, defaultUrlVariables, providedUrlVariables)
+	parameterizedURL, err := parameterizedServiceURL(providedUrlVariables["endpoint_type"])
+	if err != nil {
+		return "", err
+	}
+	urlVariables := make(map[string]string, len(providedUrlVariables))
+	for name, value := range providedUrlVariables {
+		if name != "endpoint_type" {
+			urlVariables[name] = value
+		}
+	}
+	return core.ConstructServiceURL(parameterizedURL, defaultUrlVariables, urlVariables)
 }
 
 // SetServiceURL sets the service URL
@@ -186,6 +272,25 @@ func (secretsManager *SecretsManagerV2) DisableRetries() {
 	secretsManager.Service.DisableRetries()
 }
 
+// DisableSSLVerification skips the verification of the server certificates of
+// this service instance, including when the HTTP client is wrapped by request
+// limits.
+func (secretsManager *SecretsManagerV2) DisableSSLVerification() {
+	secretsManager.Service.DisableSSLVerification()
+	if transport, ok := secretsManager.Service.GetHTTPClient().Transport.(*clientTransport); ok {
+		transport.disableSSLVerification()
+	}
+}
+
+// IsSSLDisabled returns true if the verification of the server certificates of
+// this service instance is disabled.
+func (secretsManager *SecretsManagerV2) IsSSLDisabled() bool {
+	if transport, ok := secretsManager.Service.GetHTTPClient().Transport.(*clientTransport); ok {
+		return transport.isSSLDisabled()
+	}
+	return secretsManager.Service.IsSSLDisabled()
+}
+
 // CreateSecretGroup : Create a new secret group
 // Create a secret group that you can use to organize secrets and control who can access them.
 //
@@ -250,7 +355,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretGroupWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -310,7 +415,7 @@ func (secretsManager *SecretsManagerV2) ListSecretGroupsWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretGroups", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secret_groups", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -379,7 +484,7 @@ func (secretsManager *SecretsManagerV2) GetSecretGroupWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -455,7 +560,7 @@ func (secretsManager *SecretsManagerV2) UpdateSecretGroupWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("UpdateSecretGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_secret_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -525,7 +630,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretGroupWithContext(ctx context
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteSecretGroup", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -597,7 +702,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecret", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -679,7 +784,7 @@ func (secretsManager *SecretsManagerV2) ListSecretsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecrets", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secrets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -751,7 +856,7 @@ func (secretsManager *SecretsManagerV2) GetSecretWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecret", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -822,7 +927,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteSecret", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -883,7 +988,7 @@ func (secretsManager *SecretsManagerV2) GetSecretMetadataWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretMetadata", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_metadata", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -959,7 +1064,7 @@ func (secretsManager *SecretsManagerV2) UpdateSecretMetadataWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("UpdateSecretMetadata", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_secret_metadata", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1035,7 +1140,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretActionWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretAction", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_action", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1109,7 +1214,7 @@ func (secretsManager *SecretsManagerV2) GetSecretByNameTypeWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretByNameType", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_by_name_type", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1185,7 +1290,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretVersionWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1256,7 +1361,7 @@ func (secretsManager *SecretsManagerV2) ListSecretVersionsWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretVersions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secret_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1330,7 +1435,7 @@ func (secretsManager *SecretsManagerV2) GetSecretVersionWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1404,7 +1509,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretVersionDataWithContext(ctx c
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteSecretVersionData", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret_version_data", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1469,7 +1574,7 @@ func (secretsManager *SecretsManagerV2) GetSecretVersionMetadataWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretVersionMetadata", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_version_metadata", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1546,7 +1651,7 @@ func (secretsManager *SecretsManagerV2) UpdateSecretVersionMetadataWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("UpdateSecretVersionMetadata", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_secret_version_metadata", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1625,7 +1730,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretVersionActionWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretVersionAction", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_version_action", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1696,7 +1801,7 @@ func (secretsManager *SecretsManagerV2) ListSecretTasksWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretTasks", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secret_tasks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1770,7 +1875,7 @@ func (secretsManager *SecretsManagerV2) GetSecretTaskWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetSecretTask", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_secret_task", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1849,7 +1954,7 @@ func (secretsManager *SecretsManagerV2) ReplaceSecretTaskWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ReplaceSecretTask", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_secret_task", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1919,7 +2024,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretTaskWithContext(ctx context.
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteSecretTask", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret_task", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1984,7 +2089,7 @@ func (secretsManager *SecretsManagerV2) ListSecretsLocksWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretsLocks", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secrets_locks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2066,7 +2171,7 @@ func (secretsManager *SecretsManagerV2) ListSecretLocksWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretLocks", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secret_locks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2160,7 +2265,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretLocksBulkWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretLocksBulk", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_locks_bulk", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2241,7 +2346,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretLocksBulkWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("DeleteSecretLocksBulk", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret_locks_bulk", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2324,7 +2429,7 @@ func (secretsManager *SecretsManagerV2) ListSecretVersionLocksWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListSecretVersionLocks", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_secret_version_locks", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2419,7 +2524,7 @@ func (secretsManager *SecretsManagerV2) CreateSecretVersionLocksBulkWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateSecretVersionLocksBulk", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_secret_version_locks_bulk", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2501,7 +2606,7 @@ func (secretsManager *SecretsManagerV2) DeleteSecretVersionLocksBulkWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("DeleteSecretVersionLocksBulk", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_secret_version_locks_bulk", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2586,7 +2691,7 @@ func (secretsManager *SecretsManagerV2) CreateConfigurationWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateConfiguration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_configuration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2662,7 +2767,7 @@ func (secretsManager *SecretsManagerV2) ListConfigurationsWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("ListConfigurations", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_configurations", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2736,7 +2841,7 @@ func (secretsManager *SecretsManagerV2) GetConfigurationWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetConfiguration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_configuration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2815,7 +2920,7 @@ func (secretsManager *SecretsManagerV2) UpdateConfigurationWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("UpdateConfiguration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_configuration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2885,7 +2990,7 @@ func (secretsManager *SecretsManagerV2) DeleteConfigurationWithContext(ctx conte
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteConfiguration", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_configuration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2966,7 +3071,7 @@ func (secretsManager *SecretsManagerV2) CreateConfigurationActionWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateConfigurationAction", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_configuration_action", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3053,7 +3158,7 @@ func (secretsManager *SecretsManagerV2) CreateNotificationsRegistrationWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("CreateNotificationsRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_notifications_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3113,7 +3218,7 @@ func (secretsManager *SecretsManagerV2) GetNotificationsRegistrationWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = secretsManager.Service.Request(request, &rawResponse)
+	response, err = secretsManager.request("GetNotificationsRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_notifications_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3173,7 +3278,7 @@ func (secretsManager *SecretsManagerV2) DeleteNotificationsRegistrationWithConte
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("DeleteNotificationsRegistration", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_notifications_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3228,7 +3333,7 @@ func (secretsManager *SecretsManagerV2) GetNotificationsRegistrationTestWithCont
 		return
 	}
 
-	response, err = secretsManager.Service.Request(request, nil)
+	response, err = secretsManager.request("GetNotificationsRegistrationTest", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_notifications_registration_test", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
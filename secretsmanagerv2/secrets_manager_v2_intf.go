@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package secretsmanagerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SecretsManagerV2Intf is the interface of the operations of the Secrets Manager API. It is implemented by
+// SecretsManagerV2, and can be implemented by test doubles and by decorators that wrap a client.
+type SecretsManagerV2Intf interface {
+	// CreateSecretGroup : Create a new secret group
+	CreateSecretGroup(createSecretGroupOptions *CreateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+	CreateSecretGroupWithContext(ctx context.Context, createSecretGroupOptions *CreateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+
+	// ListSecretGroups : List secret groups
+	ListSecretGroups(listSecretGroupsOptions *ListSecretGroupsOptions) (result *SecretGroupCollection, response *core.DetailedResponse, err error)
+	ListSecretGroupsWithContext(ctx context.Context, listSecretGroupsOptions *ListSecretGroupsOptions) (result *SecretGroupCollection, response *core.DetailedResponse, err error)
+
+	// GetSecretGroup : Get a secret group
+	GetSecretGroup(getSecretGroupOptions *GetSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+	GetSecretGroupWithContext(ctx context.Context, getSecretGroupOptions *GetSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+
+	// UpdateSecretGroup : Update a secret group
+	UpdateSecretGroup(updateSecretGroupOptions *UpdateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+	UpdateSecretGroupWithContext(ctx context.Context, updateSecretGroupOptions *UpdateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error)
+
+	// DeleteSecretGroup : Delete a secret group
+	DeleteSecretGroup(deleteSecretGroupOptions *DeleteSecretGroupOptions) (response *core.DetailedResponse, err error)
+	DeleteSecretGroupWithContext(ctx context.Context, deleteSecretGroupOptions *DeleteSecretGroupOptions) (response *core.DetailedResponse, err error)
+
+	// CreateSecret : Create a new secret
+	CreateSecret(createSecretOptions *CreateSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+	CreateSecretWithContext(ctx context.Context, createSecretOptions *CreateSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+
+	// ListSecrets : List secrets
+	ListSecrets(listSecretsOptions *ListSecretsOptions) (result *SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+	ListSecretsWithContext(ctx context.Context, listSecretsOptions *ListSecretsOptions) (result *SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+
+	// GetSecret : Get a secret
+	GetSecret(getSecretOptions *GetSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+	GetSecretWithContext(ctx context.Context, getSecretOptions *GetSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+
+	// DeleteSecret : Delete a secret
+	DeleteSecret(deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error)
+	DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error)
+
+	// GetSecretMetadata : Get the metadata of a secret
+	GetSecretMetadata(getSecretMetadataOptions *GetSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error)
+	GetSecretMetadataWithContext(ctx context.Context, getSecretMetadataOptions *GetSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error)
+
+	// UpdateSecretMetadata : Update the metadata of a secret
+	UpdateSecretMetadata(updateSecretMetadataOptions *UpdateSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error)
+	UpdateSecretMetadataWithContext(ctx context.Context, updateSecretMetadataOptions *UpdateSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error)
+
+	// CreateSecretAction : Create a secret action
+	CreateSecretAction(createSecretActionOptions *CreateSecretActionOptions) (result SecretActionIntf, response *core.DetailedResponse, err error)
+	CreateSecretActionWithContext(ctx context.Context, createSecretActionOptions *CreateSecretActionOptions) (result SecretActionIntf, response *core.DetailedResponse, err error)
+
+	// GetSecretByNameType : Get a secret by name
+	GetSecretByNameType(getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+	GetSecretByNameTypeWithContext(ctx context.Context, getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, response *core.DetailedResponse, err error)
+
+	// CreateSecretVersion : Create a new secret version
+	CreateSecretVersion(createSecretVersionOptions *CreateSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error)
+	CreateSecretVersionWithContext(ctx context.Context, createSecretVersionOptions *CreateSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error)
+
+	// ListSecretVersions : List versions of a secret
+	ListSecretVersions(listSecretVersionsOptions *ListSecretVersionsOptions) (result *SecretVersionMetadataCollection, response *core.DetailedResponse, err error)
+	ListSecretVersionsWithContext(ctx context.Context, listSecretVersionsOptions *ListSecretVersionsOptions) (result *SecretVersionMetadataCollection, response *core.DetailedResponse, err error)
+
+	// GetSecretVersion : Get a version of a secret
+	GetSecretVersion(getSecretVersionOptions *GetSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error)
+	GetSecretVersionWithContext(ctx context.Context, getSecretVersionOptions *GetSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error)
+
+	// DeleteSecretVersionData : Delete the data of a secret version
+	DeleteSecretVersionData(deleteSecretVersionDataOptions *DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error)
+	DeleteSecretVersionDataWithContext(ctx context.Context, deleteSecretVersionDataOptions *DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error)
+
+	// GetSecretVersionMetadata : Get the metadata of a secret version
+	GetSecretVersionMetadata(getSecretVersionMetadataOptions *GetSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+	GetSecretVersionMetadataWithContext(ctx context.Context, getSecretVersionMetadataOptions *GetSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+
+	// UpdateSecretVersionMetadata : Update the metadata of a secret version
+	UpdateSecretVersionMetadata(updateSecretVersionMetadataOptions *UpdateSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+	UpdateSecretVersionMetadataWithContext(ctx context.Context, updateSecretVersionMetadataOptions *UpdateSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+
+	// CreateSecretVersionAction : Create a version action
+	CreateSecretVersionAction(createSecretVersionActionOptions *CreateSecretVersionActionOptions) (result VersionActionIntf, response *core.DetailedResponse, err error)
+	CreateSecretVersionActionWithContext(ctx context.Context, createSecretVersionActionOptions *CreateSecretVersionActionOptions) (result VersionActionIntf, response *core.DetailedResponse, err error)
+
+	// ListSecretTasks : List secret tasks
+	ListSecretTasks(listSecretTasksOptions *ListSecretTasksOptions) (result *SecretTaskCollection, response *core.DetailedResponse, err error)
+	ListSecretTasksWithContext(ctx context.Context, listSecretTasksOptions *ListSecretTasksOptions) (result *SecretTaskCollection, response *core.DetailedResponse, err error)
+
+	// GetSecretTask : Get a secret's task
+	GetSecretTask(getSecretTaskOptions *GetSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error)
+	GetSecretTaskWithContext(ctx context.Context, getSecretTaskOptions *GetSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error)
+
+	// ReplaceSecretTask : Update a secret's task
+	ReplaceSecretTask(replaceSecretTaskOptions *ReplaceSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error)
+	ReplaceSecretTaskWithContext(ctx context.Context, replaceSecretTaskOptions *ReplaceSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error)
+
+	// DeleteSecretTask : Delete a task
+	DeleteSecretTask(deleteSecretTaskOptions *DeleteSecretTaskOptions) (response *core.DetailedResponse, err error)
+	DeleteSecretTaskWithContext(ctx context.Context, deleteSecretTaskOptions *DeleteSecretTaskOptions) (response *core.DetailedResponse, err error)
+
+	// ListSecretsLocks : List secrets and their locks
+	ListSecretsLocks(listSecretsLocksOptions *ListSecretsLocksOptions) (result *SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	ListSecretsLocksWithContext(ctx context.Context, listSecretsLocksOptions *ListSecretsLocksOptions) (result *SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error)
+
+	// ListSecretLocks : List secret locks
+	ListSecretLocks(listSecretLocksOptions *ListSecretLocksOptions) (result *SecretLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	ListSecretLocksWithContext(ctx context.Context, listSecretLocksOptions *ListSecretLocksOptions) (result *SecretLocksPaginatedCollection, response *core.DetailedResponse, err error)
+
+	// CreateSecretLocksBulk : Create secret locks
+	CreateSecretLocksBulk(createSecretLocksBulkOptions *CreateSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+	CreateSecretLocksBulkWithContext(ctx context.Context, createSecretLocksBulkOptions *CreateSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+
+	// DeleteSecretLocksBulk : Delete secret locks
+	DeleteSecretLocksBulk(deleteSecretLocksBulkOptions *DeleteSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+	DeleteSecretLocksBulkWithContext(ctx context.Context, deleteSecretLocksBulkOptions *DeleteSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+
+	// ListSecretVersionLocks : List secret version locks
+	ListSecretVersionLocks(listSecretVersionLocksOptions *ListSecretVersionLocksOptions) (result *SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	ListSecretVersionLocksWithContext(ctx context.Context, listSecretVersionLocksOptions *ListSecretVersionLocksOptions) (result *SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error)
+
+	// CreateSecretVersionLocksBulk : Create secret version locks
+	CreateSecretVersionLocksBulk(createSecretVersionLocksBulkOptions *CreateSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+	CreateSecretVersionLocksBulkWithContext(ctx context.Context, createSecretVersionLocksBulkOptions *CreateSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+
+	// DeleteSecretVersionLocksBulk : Delete locks on a secret version
+	DeleteSecretVersionLocksBulk(deleteSecretVersionLocksBulkOptions *DeleteSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+	DeleteSecretVersionLocksBulkWithContext(ctx context.Context, deleteSecretVersionLocksBulkOptions *DeleteSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error)
+
+	// CreateConfiguration : Create a new configuration
+	CreateConfiguration(createConfigurationOptions *CreateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+	CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+
+	// ListConfigurations : List configurations
+	ListConfigurations(listConfigurationsOptions *ListConfigurationsOptions) (result *ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+	ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+
+	// GetConfiguration : Get a configuration
+	GetConfiguration(getConfigurationOptions *GetConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+	GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+
+	// UpdateConfiguration : Update configuration
+	UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+	UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error)
+
+	// DeleteConfiguration : Delete a configuration
+	DeleteConfiguration(deleteConfigurationOptions *DeleteConfigurationOptions) (response *core.DetailedResponse, err error)
+	DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (response *core.DetailedResponse, err error)
+
+	// CreateConfigurationAction : Create a configuration action
+	CreateConfigurationAction(createConfigurationActionOptions *CreateConfigurationActionOptions) (result ConfigurationActionIntf, response *core.DetailedResponse, err error)
+	CreateConfigurationActionWithContext(ctx context.Context, createConfigurationActionOptions *CreateConfigurationActionOptions) (result ConfigurationActionIntf, response *core.DetailedResponse, err error)
+
+	// CreateNotificationsRegistration : Register with Event Notifications instance
+	CreateNotificationsRegistration(createNotificationsRegistrationOptions *CreateNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error)
+	CreateNotificationsRegistrationWithContext(ctx context.Context, createNotificationsRegistrationOptions *CreateNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error)
+
+	// GetNotificationsRegistration : Get Event Notifications registration details
+	GetNotificationsRegistration(getNotificationsRegistrationOptions *GetNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error)
+	GetNotificationsRegistrationWithContext(ctx context.Context, getNotificationsRegistrationOptions *GetNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error)
+
+	// DeleteNotificationsRegistration : Unregister from Event Notifications instance
+	DeleteNotificationsRegistration(deleteNotificationsRegistrationOptions *DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error)
+	DeleteNotificationsRegistrationWithContext(ctx context.Context, deleteNotificationsRegistrationOptions *DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error)
+
+	// GetNotificationsRegistrationTest : Send a test event for Event Notifications registrations
+	GetNotificationsRegistrationTest(getNotificationsRegistrationTestOptions *GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error)
+	GetNotificationsRegistrationTestWithContext(ctx context.Context, getNotificationsRegistrationTestOptions *GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ SecretsManagerV2Intf = (*SecretsManagerV2)(nil)
@@ -0,0 +1,681 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package secretsmanagerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateSecretGroup sends the CreateSecretGroup operation to the primary instance.
+func (router *InstanceRouter) CreateSecretGroup(createSecretGroupOptions *CreateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretGroupWithContext(context.Background(), createSecretGroupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretGroupWithContext sends the CreateSecretGroup operation to the primary instance.
+func (router *InstanceRouter) CreateSecretGroupWithContext(ctx context.Context, createSecretGroupOptions *CreateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretGroup", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretGroupWithContext(ctx, createSecretGroupOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretGroups sends the ListSecretGroups operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretGroups(listSecretGroupsOptions *ListSecretGroupsOptions) (result *SecretGroupCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretGroupsWithContext(context.Background(), listSecretGroupsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretGroupsWithContext sends the ListSecretGroups operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretGroupsWithContext(ctx context.Context, listSecretGroupsOptions *ListSecretGroupsOptions) (result *SecretGroupCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretGroups", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretGroupsWithContext(ctx, listSecretGroupsOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretGroup sends the GetSecretGroup operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretGroup(getSecretGroupOptions *GetSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretGroupWithContext(context.Background(), getSecretGroupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretGroupWithContext sends the GetSecretGroup operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretGroupWithContext(ctx context.Context, getSecretGroupOptions *GetSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretGroup", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretGroupWithContext(ctx, getSecretGroupOptions)
+		return response, err
+	})
+	return
+}
+
+// UpdateSecretGroup sends the UpdateSecretGroup operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretGroup(updateSecretGroupOptions *UpdateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	result, response, err = router.UpdateSecretGroupWithContext(context.Background(), updateSecretGroupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateSecretGroupWithContext sends the UpdateSecretGroup operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretGroupWithContext(ctx context.Context, updateSecretGroupOptions *UpdateSecretGroupOptions) (result *SecretGroup, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "UpdateSecretGroup", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.UpdateSecretGroupWithContext(ctx, updateSecretGroupOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecretGroup sends the DeleteSecretGroup operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretGroup(deleteSecretGroupOptions *DeleteSecretGroupOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteSecretGroupWithContext(context.Background(), deleteSecretGroupOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretGroupWithContext sends the DeleteSecretGroup operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretGroupWithContext(ctx context.Context, deleteSecretGroupOptions *DeleteSecretGroupOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecretGroup", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteSecretGroupWithContext(ctx, deleteSecretGroupOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecret sends the CreateSecret operation to the primary instance.
+func (router *InstanceRouter) CreateSecret(createSecretOptions *CreateSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretWithContext(context.Background(), createSecretOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretWithContext sends the CreateSecret operation to the primary instance.
+func (router *InstanceRouter) CreateSecretWithContext(ctx context.Context, createSecretOptions *CreateSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecret", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretWithContext(ctx, createSecretOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecrets sends the ListSecrets operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecrets(listSecretsOptions *ListSecretsOptions) (result *SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretsWithContext(context.Background(), listSecretsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretsWithContext sends the ListSecrets operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretsWithContext(ctx context.Context, listSecretsOptions *ListSecretsOptions) (result *SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecrets", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretsWithContext(ctx, listSecretsOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecret sends the GetSecret operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecret(getSecretOptions *GetSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretWithContext(context.Background(), getSecretOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretWithContext sends the GetSecret operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretWithContext(ctx context.Context, getSecretOptions *GetSecretOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecret", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretWithContext(ctx, getSecretOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecret sends the DeleteSecret operation to the primary instance.
+func (router *InstanceRouter) DeleteSecret(deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteSecretWithContext(context.Background(), deleteSecretOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretWithContext sends the DeleteSecret operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecret", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteSecretWithContext(ctx, deleteSecretOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretMetadata sends the GetSecretMetadata operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretMetadata(getSecretMetadataOptions *GetSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretMetadataWithContext(context.Background(), getSecretMetadataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretMetadataWithContext sends the GetSecretMetadata operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretMetadataWithContext(ctx context.Context, getSecretMetadataOptions *GetSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretMetadata", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretMetadataWithContext(ctx, getSecretMetadataOptions)
+		return response, err
+	})
+	return
+}
+
+// UpdateSecretMetadata sends the UpdateSecretMetadata operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretMetadata(updateSecretMetadataOptions *UpdateSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.UpdateSecretMetadataWithContext(context.Background(), updateSecretMetadataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateSecretMetadataWithContext sends the UpdateSecretMetadata operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretMetadataWithContext(ctx context.Context, updateSecretMetadataOptions *UpdateSecretMetadataOptions) (result SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "UpdateSecretMetadata", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.UpdateSecretMetadataWithContext(ctx, updateSecretMetadataOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecretAction sends the CreateSecretAction operation to the primary instance.
+func (router *InstanceRouter) CreateSecretAction(createSecretActionOptions *CreateSecretActionOptions) (result SecretActionIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretActionWithContext(context.Background(), createSecretActionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretActionWithContext sends the CreateSecretAction operation to the primary instance.
+func (router *InstanceRouter) CreateSecretActionWithContext(ctx context.Context, createSecretActionOptions *CreateSecretActionOptions) (result SecretActionIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretAction", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretActionWithContext(ctx, createSecretActionOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretByNameType sends the GetSecretByNameType operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretByNameType(getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretByNameTypeWithContext(context.Background(), getSecretByNameTypeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretByNameTypeWithContext sends the GetSecretByNameType operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretByNameTypeWithContext(ctx context.Context, getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result SecretIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretByNameType", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretByNameTypeWithContext(ctx, getSecretByNameTypeOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecretVersion sends the CreateSecretVersion operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersion(createSecretVersionOptions *CreateSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretVersionWithContext(context.Background(), createSecretVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretVersionWithContext sends the CreateSecretVersion operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersionWithContext(ctx context.Context, createSecretVersionOptions *CreateSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretVersion", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretVersionWithContext(ctx, createSecretVersionOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretVersions sends the ListSecretVersions operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretVersions(listSecretVersionsOptions *ListSecretVersionsOptions) (result *SecretVersionMetadataCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretVersionsWithContext(context.Background(), listSecretVersionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretVersionsWithContext sends the ListSecretVersions operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretVersionsWithContext(ctx context.Context, listSecretVersionsOptions *ListSecretVersionsOptions) (result *SecretVersionMetadataCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretVersions", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretVersionsWithContext(ctx, listSecretVersionsOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretVersion sends the GetSecretVersion operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretVersion(getSecretVersionOptions *GetSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretVersionWithContext(context.Background(), getSecretVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretVersionWithContext sends the GetSecretVersion operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretVersionWithContext(ctx context.Context, getSecretVersionOptions *GetSecretVersionOptions) (result SecretVersionIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretVersion", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretVersionWithContext(ctx, getSecretVersionOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecretVersionData sends the DeleteSecretVersionData operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretVersionData(deleteSecretVersionDataOptions *DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteSecretVersionDataWithContext(context.Background(), deleteSecretVersionDataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretVersionDataWithContext sends the DeleteSecretVersionData operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretVersionDataWithContext(ctx context.Context, deleteSecretVersionDataOptions *DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecretVersionData", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteSecretVersionDataWithContext(ctx, deleteSecretVersionDataOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretVersionMetadata sends the GetSecretVersionMetadata operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretVersionMetadata(getSecretVersionMetadataOptions *GetSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretVersionMetadataWithContext(context.Background(), getSecretVersionMetadataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretVersionMetadataWithContext sends the GetSecretVersionMetadata operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretVersionMetadataWithContext(ctx context.Context, getSecretVersionMetadataOptions *GetSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretVersionMetadata", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretVersionMetadataWithContext(ctx, getSecretVersionMetadataOptions)
+		return response, err
+	})
+	return
+}
+
+// UpdateSecretVersionMetadata sends the UpdateSecretVersionMetadata operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretVersionMetadata(updateSecretVersionMetadataOptions *UpdateSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.UpdateSecretVersionMetadataWithContext(context.Background(), updateSecretVersionMetadataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateSecretVersionMetadataWithContext sends the UpdateSecretVersionMetadata operation to the primary instance.
+func (router *InstanceRouter) UpdateSecretVersionMetadataWithContext(ctx context.Context, updateSecretVersionMetadataOptions *UpdateSecretVersionMetadataOptions) (result SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "UpdateSecretVersionMetadata", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.UpdateSecretVersionMetadataWithContext(ctx, updateSecretVersionMetadataOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecretVersionAction sends the CreateSecretVersionAction operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersionAction(createSecretVersionActionOptions *CreateSecretVersionActionOptions) (result VersionActionIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretVersionActionWithContext(context.Background(), createSecretVersionActionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretVersionActionWithContext sends the CreateSecretVersionAction operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersionActionWithContext(ctx context.Context, createSecretVersionActionOptions *CreateSecretVersionActionOptions) (result VersionActionIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretVersionAction", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretVersionActionWithContext(ctx, createSecretVersionActionOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretTasks sends the ListSecretTasks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretTasks(listSecretTasksOptions *ListSecretTasksOptions) (result *SecretTaskCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretTasksWithContext(context.Background(), listSecretTasksOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretTasksWithContext sends the ListSecretTasks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretTasksWithContext(ctx context.Context, listSecretTasksOptions *ListSecretTasksOptions) (result *SecretTaskCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretTasks", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretTasksWithContext(ctx, listSecretTasksOptions)
+		return response, err
+	})
+	return
+}
+
+// GetSecretTask sends the GetSecretTask operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretTask(getSecretTaskOptions *GetSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetSecretTaskWithContext(context.Background(), getSecretTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretTaskWithContext sends the GetSecretTask operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetSecretTaskWithContext(ctx context.Context, getSecretTaskOptions *GetSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetSecretTask", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetSecretTaskWithContext(ctx, getSecretTaskOptions)
+		return response, err
+	})
+	return
+}
+
+// ReplaceSecretTask sends the ReplaceSecretTask operation to the primary instance.
+func (router *InstanceRouter) ReplaceSecretTask(replaceSecretTaskOptions *ReplaceSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error) {
+	result, response, err = router.ReplaceSecretTaskWithContext(context.Background(), replaceSecretTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReplaceSecretTaskWithContext sends the ReplaceSecretTask operation to the primary instance.
+func (router *InstanceRouter) ReplaceSecretTaskWithContext(ctx context.Context, replaceSecretTaskOptions *ReplaceSecretTaskOptions) (result *SecretTask, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "ReplaceSecretTask", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ReplaceSecretTaskWithContext(ctx, replaceSecretTaskOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecretTask sends the DeleteSecretTask operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretTask(deleteSecretTaskOptions *DeleteSecretTaskOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteSecretTaskWithContext(context.Background(), deleteSecretTaskOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretTaskWithContext sends the DeleteSecretTask operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretTaskWithContext(ctx context.Context, deleteSecretTaskOptions *DeleteSecretTaskOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecretTask", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteSecretTaskWithContext(ctx, deleteSecretTaskOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretsLocks sends the ListSecretsLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretsLocks(listSecretsLocksOptions *ListSecretsLocksOptions) (result *SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretsLocksWithContext(context.Background(), listSecretsLocksOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretsLocksWithContext sends the ListSecretsLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretsLocksWithContext(ctx context.Context, listSecretsLocksOptions *ListSecretsLocksOptions) (result *SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretsLocks", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretsLocksWithContext(ctx, listSecretsLocksOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretLocks sends the ListSecretLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretLocks(listSecretLocksOptions *ListSecretLocksOptions) (result *SecretLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretLocksWithContext(context.Background(), listSecretLocksOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretLocksWithContext sends the ListSecretLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretLocksWithContext(ctx context.Context, listSecretLocksOptions *ListSecretLocksOptions) (result *SecretLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretLocks", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretLocksWithContext(ctx, listSecretLocksOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecretLocksBulk sends the CreateSecretLocksBulk operation to the primary instance.
+func (router *InstanceRouter) CreateSecretLocksBulk(createSecretLocksBulkOptions *CreateSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretLocksBulkWithContext(context.Background(), createSecretLocksBulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretLocksBulkWithContext sends the CreateSecretLocksBulk operation to the primary instance.
+func (router *InstanceRouter) CreateSecretLocksBulkWithContext(ctx context.Context, createSecretLocksBulkOptions *CreateSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretLocksBulk", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretLocksBulkWithContext(ctx, createSecretLocksBulkOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecretLocksBulk sends the DeleteSecretLocksBulk operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretLocksBulk(deleteSecretLocksBulkOptions *DeleteSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	result, response, err = router.DeleteSecretLocksBulkWithContext(context.Background(), deleteSecretLocksBulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretLocksBulkWithContext sends the DeleteSecretLocksBulk operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretLocksBulkWithContext(ctx context.Context, deleteSecretLocksBulkOptions *DeleteSecretLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecretLocksBulk", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.DeleteSecretLocksBulkWithContext(ctx, deleteSecretLocksBulkOptions)
+		return response, err
+	})
+	return
+}
+
+// ListSecretVersionLocks sends the ListSecretVersionLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretVersionLocks(listSecretVersionLocksOptions *ListSecretVersionLocksOptions) (result *SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListSecretVersionLocksWithContext(context.Background(), listSecretVersionLocksOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSecretVersionLocksWithContext sends the ListSecretVersionLocks operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListSecretVersionLocksWithContext(ctx context.Context, listSecretVersionLocksOptions *ListSecretVersionLocksOptions) (result *SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListSecretVersionLocks", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListSecretVersionLocksWithContext(ctx, listSecretVersionLocksOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateSecretVersionLocksBulk sends the CreateSecretVersionLocksBulk operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersionLocksBulk(createSecretVersionLocksBulkOptions *CreateSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateSecretVersionLocksBulkWithContext(context.Background(), createSecretVersionLocksBulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSecretVersionLocksBulkWithContext sends the CreateSecretVersionLocksBulk operation to the primary instance.
+func (router *InstanceRouter) CreateSecretVersionLocksBulkWithContext(ctx context.Context, createSecretVersionLocksBulkOptions *CreateSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateSecretVersionLocksBulk", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateSecretVersionLocksBulkWithContext(ctx, createSecretVersionLocksBulkOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteSecretVersionLocksBulk sends the DeleteSecretVersionLocksBulk operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretVersionLocksBulk(deleteSecretVersionLocksBulkOptions *DeleteSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	result, response, err = router.DeleteSecretVersionLocksBulkWithContext(context.Background(), deleteSecretVersionLocksBulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSecretVersionLocksBulkWithContext sends the DeleteSecretVersionLocksBulk operation to the primary instance.
+func (router *InstanceRouter) DeleteSecretVersionLocksBulkWithContext(ctx context.Context, deleteSecretVersionLocksBulkOptions *DeleteSecretVersionLocksBulkOptions) (result *SecretLocks, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteSecretVersionLocksBulk", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.DeleteSecretVersionLocksBulkWithContext(ctx, deleteSecretVersionLocksBulkOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateConfiguration sends the CreateConfiguration operation to the primary instance.
+func (router *InstanceRouter) CreateConfiguration(createConfigurationOptions *CreateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateConfigurationWithContext(context.Background(), createConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateConfigurationWithContext sends the CreateConfiguration operation to the primary instance.
+func (router *InstanceRouter) CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *CreateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateConfiguration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateConfigurationWithContext(ctx, createConfigurationOptions)
+		return response, err
+	})
+	return
+}
+
+// ListConfigurations sends the ListConfigurations operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListConfigurations(listConfigurationsOptions *ListConfigurationsOptions) (result *ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	result, response, err = router.ListConfigurationsWithContext(context.Background(), listConfigurationsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListConfigurationsWithContext sends the ListConfigurations operation to the first healthy instance, with failover.
+func (router *InstanceRouter) ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *ListConfigurationsOptions) (result *ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "ListConfigurations", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.ListConfigurationsWithContext(ctx, listConfigurationsOptions)
+		return response, err
+	})
+	return
+}
+
+// GetConfiguration sends the GetConfiguration operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetConfiguration(getConfigurationOptions *GetConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetConfigurationWithContext(context.Background(), getConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetConfigurationWithContext sends the GetConfiguration operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetConfiguration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetConfigurationWithContext(ctx, getConfigurationOptions)
+		return response, err
+	})
+	return
+}
+
+// UpdateConfiguration sends the UpdateConfiguration operation to the primary instance.
+func (router *InstanceRouter) UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.UpdateConfigurationWithContext(context.Background(), updateConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateConfigurationWithContext sends the UpdateConfiguration operation to the primary instance.
+func (router *InstanceRouter) UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result ConfigurationIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "UpdateConfiguration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.UpdateConfigurationWithContext(ctx, updateConfigurationOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteConfiguration sends the DeleteConfiguration operation to the primary instance.
+func (router *InstanceRouter) DeleteConfiguration(deleteConfigurationOptions *DeleteConfigurationOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteConfigurationWithContext(context.Background(), deleteConfigurationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteConfigurationWithContext sends the DeleteConfiguration operation to the primary instance.
+func (router *InstanceRouter) DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *DeleteConfigurationOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteConfiguration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteConfigurationWithContext(ctx, deleteConfigurationOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateConfigurationAction sends the CreateConfigurationAction operation to the primary instance.
+func (router *InstanceRouter) CreateConfigurationAction(createConfigurationActionOptions *CreateConfigurationActionOptions) (result ConfigurationActionIntf, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateConfigurationActionWithContext(context.Background(), createConfigurationActionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateConfigurationActionWithContext sends the CreateConfigurationAction operation to the primary instance.
+func (router *InstanceRouter) CreateConfigurationActionWithContext(ctx context.Context, createConfigurationActionOptions *CreateConfigurationActionOptions) (result ConfigurationActionIntf, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateConfigurationAction", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateConfigurationActionWithContext(ctx, createConfigurationActionOptions)
+		return response, err
+	})
+	return
+}
+
+// CreateNotificationsRegistration sends the CreateNotificationsRegistration operation to the primary instance.
+func (router *InstanceRouter) CreateNotificationsRegistration(createNotificationsRegistrationOptions *CreateNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error) {
+	result, response, err = router.CreateNotificationsRegistrationWithContext(context.Background(), createNotificationsRegistrationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateNotificationsRegistrationWithContext sends the CreateNotificationsRegistration operation to the primary instance.
+func (router *InstanceRouter) CreateNotificationsRegistrationWithContext(ctx context.Context, createNotificationsRegistrationOptions *CreateNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "CreateNotificationsRegistration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.CreateNotificationsRegistrationWithContext(ctx, createNotificationsRegistrationOptions)
+		return response, err
+	})
+	return
+}
+
+// GetNotificationsRegistration sends the GetNotificationsRegistration operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetNotificationsRegistration(getNotificationsRegistrationOptions *GetNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error) {
+	result, response, err = router.GetNotificationsRegistrationWithContext(context.Background(), getNotificationsRegistrationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetNotificationsRegistrationWithContext sends the GetNotificationsRegistration operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetNotificationsRegistrationWithContext(ctx context.Context, getNotificationsRegistrationOptions *GetNotificationsRegistrationOptions) (result *NotificationsRegistration, response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetNotificationsRegistration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		result, response, err = client.GetNotificationsRegistrationWithContext(ctx, getNotificationsRegistrationOptions)
+		return response, err
+	})
+	return
+}
+
+// DeleteNotificationsRegistration sends the DeleteNotificationsRegistration operation to the primary instance.
+func (router *InstanceRouter) DeleteNotificationsRegistration(deleteNotificationsRegistrationOptions *DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.DeleteNotificationsRegistrationWithContext(context.Background(), deleteNotificationsRegistrationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteNotificationsRegistrationWithContext sends the DeleteNotificationsRegistration operation to the primary instance.
+func (router *InstanceRouter) DeleteNotificationsRegistrationWithContext(ctx context.Context, deleteNotificationsRegistrationOptions *DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.write(ctx, "DeleteNotificationsRegistration", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.DeleteNotificationsRegistrationWithContext(ctx, deleteNotificationsRegistrationOptions)
+		return response, err
+	})
+	return
+}
+
+// GetNotificationsRegistrationTest sends the GetNotificationsRegistrationTest operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetNotificationsRegistrationTest(getNotificationsRegistrationTestOptions *GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.GetNotificationsRegistrationTestWithContext(context.Background(), getNotificationsRegistrationTestOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetNotificationsRegistrationTestWithContext sends the GetNotificationsRegistrationTest operation to the first healthy instance, with failover.
+func (router *InstanceRouter) GetNotificationsRegistrationTestWithContext(ctx context.Context, getNotificationsRegistrationTestOptions *GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error) {
+	response, err = router.read(ctx, "GetNotificationsRegistrationTest", func(ctx context.Context, client SecretsManagerV2Intf) (*core.DetailedResponse, error) {
+		response, err = client.GetNotificationsRegistrationTestWithContext(ctx, getNotificationsRegistrationTestOptions)
+		return response, err
+	})
+	return
+}
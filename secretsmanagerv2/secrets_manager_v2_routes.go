@@ -0,0 +1,64 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package secretsmanagerv2
+
+// operationRoutes lists the HTTP method and the path template of the requests of each operation.
+var operationRoutes = []operationRoute{
+	{"CreateSecretGroup", "POST", "/api/v2/secret_groups"},
+	{"ListSecretGroups", "GET", "/api/v2/secret_groups"},
+	{"GetSecretGroup", "GET", "/api/v2/secret_groups/{id}"},
+	{"UpdateSecretGroup", "PATCH", "/api/v2/secret_groups/{id}"},
+	{"DeleteSecretGroup", "DELETE", "/api/v2/secret_groups/{id}"},
+	{"CreateSecret", "POST", "/api/v2/secrets"},
+	{"ListSecrets", "GET", "/api/v2/secrets"},
+	{"GetSecret", "GET", "/api/v2/secrets/{id}"},
+	{"DeleteSecret", "DELETE", "/api/v2/secrets/{id}"},
+	{"GetSecretMetadata", "GET", "/api/v2/secrets/{id}/metadata"},
+	{"UpdateSecretMetadata", "PATCH", "/api/v2/secrets/{id}/metadata"},
+	{"CreateSecretAction", "POST", "/api/v2/secrets/{id}/actions"},
+	{"GetSecretByNameType", "GET", "/api/v2/secret_groups/{secret_group_name}/secret_types/{secret_type}/secrets/{name}"},
+	{"CreateSecretVersion", "POST", "/api/v2/secrets/{secret_id}/versions"},
+	{"ListSecretVersions", "GET", "/api/v2/secrets/{secret_id}/versions"},
+	{"GetSecretVersion", "GET", "/api/v2/secrets/{secret_id}/versions/{id}"},
+	{"DeleteSecretVersionData", "DELETE", "/api/v2/secrets/{secret_id}/versions/{id}/secret_data"},
+	{"GetSecretVersionMetadata", "GET", "/api/v2/secrets/{secret_id}/versions/{id}/metadata"},
+	{"UpdateSecretVersionMetadata", "PATCH", "/api/v2/secrets/{secret_id}/versions/{id}/metadata"},
+	{"CreateSecretVersionAction", "POST", "/api/v2/secrets/{secret_id}/versions/{id}/actions"},
+	{"ListSecretTasks", "GET", "/api/v2/secrets/{secret_id}/tasks"},
+	{"GetSecretTask", "GET", "/api/v2/secrets/{secret_id}/tasks/{id}"},
+	{"ReplaceSecretTask", "PUT", "/api/v2/secrets/{secret_id}/tasks/{id}"},
+	{"DeleteSecretTask", "DELETE", "/api/v2/secrets/{secret_id}/tasks/{id}"},
+	{"ListSecretsLocks", "GET", "/api/v2/secrets_locks"},
+	{"ListSecretLocks", "GET", "/api/v2/secrets/{id}/locks"},
+	{"CreateSecretLocksBulk", "POST", "/api/v2/secrets/{id}/locks_bulk"},
+	{"DeleteSecretLocksBulk", "DELETE", "/api/v2/secrets/{id}/locks_bulk"},
+	{"ListSecretVersionLocks", "GET", "/api/v2/secrets/{secret_id}/versions/{id}/locks"},
+	{"CreateSecretVersionLocksBulk", "POST", "/api/v2/secrets/{secret_id}/versions/{id}/locks_bulk"},
+	{"DeleteSecretVersionLocksBulk", "DELETE", "/api/v2/secrets/{secret_id}/versions/{id}/locks_bulk"},
+	{"CreateConfiguration", "POST", "/api/v2/configurations"},
+	{"ListConfigurations", "GET", "/api/v2/configurations"},
+	{"GetConfiguration", "GET", "/api/v2/configurations/{name}"},
+	{"UpdateConfiguration", "PATCH", "/api/v2/configurations/{name}"},
+	{"DeleteConfiguration", "DELETE", "/api/v2/configurations/{name}"},
+	{"CreateConfigurationAction", "POST", "/api/v2/configurations/{name}/actions"},
+	{"CreateNotificationsRegistration", "POST", "/api/v2/notifications/registration"},
+	{"GetNotificationsRegistration", "GET", "/api/v2/notifications/registration"},
+	{"DeleteNotificationsRegistration", "DELETE", "/api/v2/notifications/registration"},
+	{"GetNotificationsRegistrationTest", "GET", "/api/v2/notifications/registration/test"},
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// The types of endpoint of a Secrets Manager instance.
+const (
+	// EndpointTypePublic is the endpoint that is reachable from the public internet.
+	EndpointTypePublic = "public"
+
+	// EndpointTypePrivate is the endpoint that is reachable from the IBM Cloud private network.
+	EndpointTypePrivate = "private"
+
+	// EndpointTypeDirect is the endpoint that is reachable from IBM Cloud classic infrastructure and Direct Link.
+	EndpointTypeDirect = "direct"
+)
+
+// ParameterizedPrivateServiceURL is the URL of the private endpoint of an instance.
+const ParameterizedPrivateServiceURL = "https://{instance_id}.private.{region}.secrets-manager.appdomain.cloud"
+
+// ParameterizedDirectServiceURL is the URL of the direct endpoint of an instance.
+const ParameterizedDirectServiceURL = "https://{instance_id}.direct.{region}.secrets-manager.appdomain.cloud"
+
+// parameterizedServiceURLs maps each endpoint type to its parameterized URL.
+var parameterizedServiceURLs = map[string]string{
+	EndpointTypePublic:  ParameterizedServiceURL,
+	EndpointTypePrivate: ParameterizedPrivateServiceURL,
+	EndpointTypeDirect:  ParameterizedDirectServiceURL,
+}
+
+// serviceRegions lists the regions where Secrets Manager instances can be created.
+var serviceRegions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-fr2",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
+// instanceIDPattern matches the ID of an instance, which is a host name label.
+var instanceIDPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// ServiceRegions returns the regions where Secrets Manager instances can be created.
+func ServiceRegions() []string {
+	return slices.Clone(serviceRegions)
+}
+
+// validateRegion returns an error if "region" is not a Secrets Manager region.
+func validateRegion(region string) error {
+	if !slices.Contains(serviceRegions, region) {
+		return core.SDKErrorf(nil, fmt.Sprintf("the region '%s' is not valid; valid regions are: %s", region, strings.Join(serviceRegions, ", ")), "invalid-region", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// parameterizedServiceURL returns the parameterized URL of an endpoint type.
+// The empty endpoint type is the public endpoint.
+func parameterizedServiceURL(endpointType string) (string, error) {
+	if endpointType == "" {
+		endpointType = EndpointTypePublic
+	}
+	parameterizedURL, ok := parameterizedServiceURLs[endpointType]
+	if !ok {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("the endpoint type '%s' is not valid; valid types are: %s, %s, %s", endpointType, EndpointTypePublic, EndpointTypePrivate, EndpointTypeDirect), "invalid-endpoint-type", common.GetComponentInfo())
+	}
+	return parameterizedURL, nil
+}
+
+// GetServiceURLForInstance returns the URL of the endpoint of a Secrets
+// Manager instance. "instance" is the ID of the instance, with "region", or
+// the CRN of the instance, in which case "region" can be empty because the
+// region of the CRN is used. "endpointType" is EndpointTypePublic,
+// EndpointTypePrivate or EndpointTypeDirect; the empty endpoint type is the
+// public endpoint.
+//
+// For example, the private endpoint of the instance
+// "crn:v1:bluemix:public:secrets-manager:us-south:a/123:abc::" is
+// "https://abc.private.us-south.secrets-manager.appdomain.cloud".
+func GetServiceURLForInstance(instance string, region string, endpointType string) (string, error) {
+	if strings.HasPrefix(instance, "crn:") {
+		crn, err := ParseCRN(instance)
+		if err != nil {
+			return "", err
+		}
+		if region != "" && region != crn.Location {
+			return "", core.SDKErrorf(nil, fmt.Sprintf("the region '%s' does not match the region '%s' of the instance CRN", region, crn.Location), "region-mismatch", common.GetComponentInfo())
+		}
+		return crn.ServiceURL(endpointType)
+	}
+	return instanceServiceURL(instance, region, endpointType)
+}
+
+// instanceServiceURL returns the URL of the endpoint of type "endpointType"
+// of the instance "instanceID" in "region".
+func instanceServiceURL(instanceID string, region string, endpointType string) (string, error) {
+	if !instanceIDPattern.MatchString(instanceID) {
+		return "", core.SDKErrorf(nil, fmt.Sprintf("the instance ID '%s' is not valid", instanceID), "invalid-instance-id", common.GetComponentInfo())
+	}
+	if err := validateRegion(region); err != nil {
+		return "", err
+	}
+	parameterizedURL, err := parameterizedServiceURL(endpointType)
+	if err != nil {
+		return "", err
+	}
+	return strings.NewReplacer("{instance_id}", instanceID, "{region}", region).Replace(parameterizedURL), nil
+}
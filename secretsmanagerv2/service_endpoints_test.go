@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Service endpoints`, func() {
+	const instanceID = "a1b2c3d4-0000-1111-2222-333344445555"
+	const instanceCRN = "crn:v1:bluemix:public:secrets-manager:eu-de:a/123456:" + instanceID + "::"
+
+	It(`Build the endpoints of an instance from its ID and region`, func() {
+		url, err := secretsmanagerv2.GetServiceURLForInstance(instanceID, "us-east", "")
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://" + instanceID + ".us-east.secrets-manager.appdomain.cloud"))
+
+		url, err = secretsmanagerv2.GetServiceURLForInstance(instanceID, "us-east", secretsmanagerv2.EndpointTypePrivate)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://" + instanceID + ".private.us-east.secrets-manager.appdomain.cloud"))
+
+		url, err = secretsmanagerv2.GetServiceURLForInstance(instanceID, "us-east", secretsmanagerv2.EndpointTypeDirect)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://" + instanceID + ".direct.us-east.secrets-manager.appdomain.cloud"))
+	})
+	It(`Build the endpoints of an instance from its CRN`, func() {
+		url, err := secretsmanagerv2.GetServiceURLForInstance(instanceCRN, "", secretsmanagerv2.EndpointTypePrivate)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://" + instanceID + ".private.eu-de.secrets-manager.appdomain.cloud"))
+
+		_, err = secretsmanagerv2.GetServiceURLForInstance(instanceCRN, "us-south", "")
+		Expect(err).To(MatchError(ContainSubstring("does not match the region 'eu-de'")))
+		_, err = secretsmanagerv2.GetServiceURLForInstance("crn:v1:bluemix:public:kms:eu-de:a/123456:"+instanceID+"::", "", "")
+		Expect(err).To(MatchError(ContainSubstring("the service name 'kms' is not 'secrets-manager'")))
+		_, err = secretsmanagerv2.GetServiceURLForInstance("crn:v1:bluemix", "", "")
+		Expect(err).To(MatchError(ContainSubstring("is not valid")))
+	})
+	It(`Invoke GetServiceURLForInstance with error: invalid arguments`, func() {
+		_, err := secretsmanagerv2.GetServiceURLForInstance(instanceID, "mars-north", "")
+		Expect(err).To(MatchError(ContainSubstring("the region 'mars-north' is not valid")))
+		_, err = secretsmanagerv2.GetServiceURLForInstance(instanceID, "us-south", "satellite")
+		Expect(err).To(MatchError(ContainSubstring("the endpoint type 'satellite' is not valid")))
+		_, err = secretsmanagerv2.GetServiceURLForInstance("evil.example.com/", "us-south", "")
+		Expect(err).To(MatchError(ContainSubstring("the instance ID 'evil.example.com/' is not valid")))
+	})
+	It(`Build parameterized URLs`, func() {
+		url, err := secretsmanagerv2.GetServiceURLForRegion("jp-tok")
+		Expect(err).To(MatchError(ContainSubstring("use GetServiceURLForInstance")))
+		Expect(url).To(BeEmpty())
+		Expect(secretsmanagerv2.ServiceRegions()).To(ContainElement("jp-tok"))
+
+		url, err = secretsmanagerv2.ConstructServiceURL(map[string]string{"instance_id": instanceID, "endpoint_type": secretsmanagerv2.EndpointTypePrivate})
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://" + instanceID + ".private.us-south.secrets-manager.appdomain.cloud"))
+		_, err = secretsmanagerv2.ConstructServiceURL(map[string]string{"endpoint_type": "satellite"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Construct a service with an endpoint type`, func() {
+		secretsManagerService, err := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			InstanceID:    instanceCRN,
+			EndpointType:  secretsmanagerv2.EndpointTypePrivate,
+		})
+		Expect(err).To(BeNil())
+		Expect(secretsManagerService.Service.GetServiceURL()).To(Equal("https://" + instanceID + ".private.eu-de.secrets-manager.appdomain.cloud"))
+
+		secretsManagerService, err = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			URL:           "https://example.com",
+			InstanceID:    instanceCRN,
+		})
+		Expect(err).To(BeNil())
+		Expect(secretsManagerService.Service.GetServiceURL()).To(Equal("https://example.com"))
+
+		_, err = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			InstanceID:    instanceID,
+			Region:        "mars-north",
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// secretTypesByModel maps each concrete secret model to the value of its
+// "secret_type" discriminator.
+var secretTypesByModel = map[reflect.Type]string{
+	reflect.TypeOf((*ArbitrarySecret)(nil)):          Secret_SecretType_Arbitrary,
+	reflect.TypeOf((*CustomCredentialsSecret)(nil)):  Secret_SecretType_CustomCredentials,
+	reflect.TypeOf((*IAMCredentialsSecret)(nil)):     Secret_SecretType_IamCredentials,
+	reflect.TypeOf((*ImportedCertificate)(nil)):      Secret_SecretType_ImportedCert,
+	reflect.TypeOf((*KVSecret)(nil)):                 Secret_SecretType_Kv,
+	reflect.TypeOf((*PrivateCertificate)(nil)):       Secret_SecretType_PrivateCert,
+	reflect.TypeOf((*PublicCertificate)(nil)):        Secret_SecretType_PublicCert,
+	reflect.TypeOf((*ServiceCredentialsSecret)(nil)): Secret_SecretType_ServiceCredentials,
+	reflect.TypeOf((*UsernamePasswordSecret)(nil)):   Secret_SecretType_UsernamePassword,
+}
+
+// SecretTypeError is the error that is returned by the typed secret accessors
+// when a secret is not of the requested type.
+type SecretTypeError struct {
+	// The ID of the secret, if it is known.
+	SecretID string
+
+	// The secret type that was requested.
+	Expected string
+
+	// The secret type that was returned by the service.
+	Actual string
+}
+
+// Error returns the message of the error.
+func (e *SecretTypeError) Error() string {
+	if e.SecretID == "" {
+		return fmt.Sprintf("expected a secret of type '%s' but found type '%s'", e.Expected, e.Actual)
+	}
+	return fmt.Sprintf("expected secret '%s' to be of type '%s' but found type '%s'", e.SecretID, e.Expected, e.Actual)
+}
+
+// SecretTypeOf returns the "secret_type" discriminator value that corresponds
+// to the secret model T, for example "kv" for *KVSecret.
+func SecretTypeOf[T SecretIntf]() (secretType string, err error) {
+	secretType, ok := secretTypesByModel[reflect.TypeOf((*T)(nil)).Elem()]
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a concrete secret model", reflect.TypeOf((*T)(nil)).Elem()), "unsupported-secret-model", common.GetComponentInfo())
+	}
+	return
+}
+
+// SecretAs converts "secret" to the secret model T. A *SecretTypeError is
+// returned if the "secret_type" of the secret does not match T.
+func SecretAs[T SecretIntf](secret SecretIntf) (result T, err error) {
+	expected, err := SecretTypeOf[T]()
+	if err != nil {
+		return
+	}
+	err = core.ValidateNotNil(secret, "secret cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	result, ok := secret.(T)
+	if !ok {
+		typeErr := &SecretTypeError{
+			SecretID: modelStringField(secret, "ID"),
+			Expected: expected,
+			Actual:   modelStringField(secret, "SecretType"),
+		}
+		err = core.SDKErrorf(typeErr, "", "secret-type-mismatch", common.GetComponentInfo())
+	}
+	return
+}
+
+// GetSecretAs invokes the "GetSecret" method of "secretsManager" and returns
+// the secret as the secret model T, for example *KVSecret. A *SecretTypeError
+// is returned if the secret is of a different type.
+func GetSecretAs[T SecretIntf](secretsManager *SecretsManagerV2, getSecretOptions *GetSecretOptions) (result T, response *core.DetailedResponse, err error) {
+	result, response, err = GetSecretAsWithContext[T](context.Background(), secretsManager, getSecretOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretAsWithContext is an alternate form of the GetSecretAs function which supports a Context parameter
+func GetSecretAsWithContext[T SecretIntf](ctx context.Context, secretsManager *SecretsManagerV2, getSecretOptions *GetSecretOptions) (result T, response *core.DetailedResponse, err error) {
+	_, err = SecretTypeOf[T]()
+	if err != nil {
+		return
+	}
+
+	secret, response, err := secretsManager.GetSecretWithContext(ctx, getSecretOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-secret-as-error")
+		return
+	}
+	result, err = SecretAs[T](secret)
+	return
+}
+
+// GetSecretByNameAs invokes the "GetSecretByNameType" method of
+// "secretsManager" and returns the secret as the secret model T. If the
+// SecretType field of the options is not set, it is derived from T; if it is
+// set, it must match T.
+func GetSecretByNameAs[T SecretIntf](secretsManager *SecretsManagerV2, getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result T, response *core.DetailedResponse, err error) {
+	result, response, err = GetSecretByNameAsWithContext[T](context.Background(), secretsManager, getSecretByNameTypeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSecretByNameAsWithContext is an alternate form of the GetSecretByNameAs function which supports a Context parameter
+func GetSecretByNameAsWithContext[T SecretIntf](ctx context.Context, secretsManager *SecretsManagerV2, getSecretByNameTypeOptions *GetSecretByNameTypeOptions) (result T, response *core.DetailedResponse, err error) {
+	expected, err := SecretTypeOf[T]()
+	if err != nil {
+		return
+	}
+	err = core.ValidateNotNil(getSecretByNameTypeOptions, "getSecretByNameTypeOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	optionsCopy := *getSecretByNameTypeOptions
+	if optionsCopy.SecretType == nil {
+		optionsCopy.SecretType = core.StringPtr(expected)
+	} else if *optionsCopy.SecretType != expected {
+		typeErr := &SecretTypeError{
+			Expected: expected,
+			Actual:   *optionsCopy.SecretType,
+		}
+		err = core.SDKErrorf(typeErr, "", "secret-type-mismatch", common.GetComponentInfo())
+		return
+	}
+
+	secret, response, err := secretsManager.GetSecretByNameTypeWithContext(ctx, &optionsCopy)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-secret-as-error")
+		return
+	}
+	result, err = SecretAs[T](secret)
+	return
+}
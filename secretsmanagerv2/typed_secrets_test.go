@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsmanagerv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Typed secret accessors`, func() {
+	var testServer *httptest.Server
+	var secretsManagerService *secretsmanagerv2.SecretsManagerV2
+	getSecretPath := "/api/v2/secrets/0b5571f7-21e6-42b7-91c5-3f5ac9793a46"
+	getSecretByNameTypePath := "/api/v2/secret_groups/default/secret_types/kv/secrets/my-secret"
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.EscapedPath()).To(BeElementOf(getSecretPath, getSecretByNameTypePath))
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, "%s", `{"id": "0b5571f7-21e6-42b7-91c5-3f5ac9793a46", "name": "my-secret", "secret_group_id": "default", "secret_type": "kv", "data": {"password": "my-password"}}`)
+		}))
+		var serviceErr error
+		secretsManagerService, serviceErr = secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke GetSecretAs successfully`, func() {
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+
+		result, response, err := secretsmanagerv2.GetSecretAs[*secretsmanagerv2.KVSecret](secretsManagerService, getSecretOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result.Data).To(HaveKeyWithValue("password", "my-password"))
+	})
+	It(`Invoke GetSecretAs with error: secret type mismatch`, func() {
+		getSecretOptionsModel := new(secretsmanagerv2.GetSecretOptions)
+		getSecretOptionsModel.ID = core.StringPtr("0b5571f7-21e6-42b7-91c5-3f5ac9793a46")
+
+		result, response, err := secretsmanagerv2.GetSecretAs[*secretsmanagerv2.ArbitrarySecret](secretsManagerService, getSecretOptionsModel)
+		Expect(err).ToNot(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result).To(BeNil())
+
+		var typeErr *secretsmanagerv2.SecretTypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+		Expect(typeErr.SecretID).To(Equal("0b5571f7-21e6-42b7-91c5-3f5ac9793a46"))
+		Expect(typeErr.Expected).To(Equal("arbitrary"))
+		Expect(typeErr.Actual).To(Equal("kv"))
+	})
+	It(`Invoke GetSecretByNameAs successfully with the secret type derived from the model`, func() {
+		getSecretByNameTypeOptionsModel := new(secretsmanagerv2.GetSecretByNameTypeOptions)
+		getSecretByNameTypeOptionsModel.Name = core.StringPtr("my-secret")
+		getSecretByNameTypeOptionsModel.SecretGroupName = core.StringPtr("default")
+
+		result, _, err := secretsmanagerv2.GetSecretByNameAs[*secretsmanagerv2.KVSecret](secretsManagerService, getSecretByNameTypeOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("my-secret"))
+		Expect(getSecretByNameTypeOptionsModel.SecretType).To(BeNil())
+	})
+	It(`Invoke GetSecretByNameAs with error: conflicting secret type`, func() {
+		getSecretByNameTypeOptionsModel := secretsManagerService.NewGetSecretByNameTypeOptions("arbitrary", "my-secret", "default")
+
+		result, response, err := secretsmanagerv2.GetSecretByNameAs[*secretsmanagerv2.KVSecret](secretsManagerService, getSecretByNameTypeOptionsModel)
+		Expect(err).ToNot(BeNil())
+		Expect(response).To(BeNil())
+		Expect(result).To(BeNil())
+
+		var typeErr *secretsmanagerv2.SecretTypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+	})
+	It(`Invoke SecretTypeOf successfully`, func() {
+		secretType, err := secretsmanagerv2.SecretTypeOf[*secretsmanagerv2.UsernamePasswordSecret]()
+		Expect(err).To(BeNil())
+		Expect(secretType).To(Equal("username_password"))
+
+		_, err = secretsmanagerv2.SecretTypeOf[secretsmanagerv2.SecretIntf]()
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,332 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package smexec runs a command with secrets from Secrets Manager injected
+// into its environment, and optionally restarts or signals the command when
+// one of the secrets gets a new version.
+//
+// Each environment variable references a field of a secret, either with a
+// secret URI such as "ibmsm://app/kv/db?field=password", or with the short
+// form "<secret_type>/<secret_group_name>/<name>#<field>", for example
+// "kv/app/db#password". The secret group defaults to "default" if the short
+// form has only two segments, and the field defaults to the main field of the
+// secret type, as described by secretsmanagerv2.SecretURI.
+package smexec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// DefaultStopTimeout is the time that is given to the command to exit after
+// Options.StopSignal is sent, before it is killed, when Options.StopTimeout is
+// not set.
+const DefaultStopTimeout = 10 * time.Second
+
+// EnvSecret : An environment variable whose value is a field of a secret.
+type EnvSecret struct {
+	// The name of the environment variable.
+	Name string
+
+	// The field of the secret.
+	URI *secretsmanagerv2.SecretURI
+}
+
+// ParseEnvSecret parses "<name>=<reference>", where the reference is a secret
+// URI or has the short form "<secret_type>/<secret_group_name>/<name>#<field>".
+func ParseEnvSecret(value string) (envSecret *EnvSecret, err error) {
+	name, reference, ok := strings.Cut(value, "=")
+	if !ok || name == "" || reference == "" {
+		return nil, fmt.Errorf("the environment secret '%s' must have the form '<name>=<reference>'", value)
+	}
+	uri, err := ParseSecretReference(reference)
+	if err != nil {
+		return
+	}
+	return &EnvSecret{Name: name, URI: uri}, nil
+}
+
+// ParseSecretReference parses a secret URI, or a reference of the form
+// "<secret_type>/[<secret_group_name>/]<name>[#<field>]".
+func ParseSecretReference(reference string) (*secretsmanagerv2.SecretURI, error) {
+	if strings.HasPrefix(reference, secretsmanagerv2.SecretURIScheme+":") {
+		return secretsmanagerv2.ParseSecretURI(reference)
+	}
+	path, field, _ := strings.Cut(reference, "#")
+	segments := strings.Split(path, "/")
+	if len(segments) == 2 {
+		segments = []string{segments[0], "default", segments[1]}
+	}
+	if len(segments) != 3 || slices.Contains(segments, "") {
+		return nil, fmt.Errorf("the secret reference '%s' must have the form '<secret_type>/<secret_group_name>/<name>#<field>'", reference)
+	}
+	return &secretsmanagerv2.SecretURI{
+		SecretType:      segments[0],
+		SecretGroupName: segments[1],
+		Name:            segments[2],
+		Field:           field,
+	}, nil
+}
+
+// ResolveEnv resolves the secrets and returns their environment variables,
+// in the form "<name>=<value>" and in the order of "secrets".
+func ResolveEnv(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secrets []EnvSecret) (env []string, err error) {
+	values := map[string]string{}
+	for _, secret := range secrets {
+		uri := secret.URI.String()
+		value, ok := values[uri]
+		if !ok {
+			value, _, err = client.ResolveSecretURIWithContext(ctx, client.NewResolveSecretURIOptions(uri))
+			if err != nil {
+				return nil, fmt.Errorf("resolving %s: %w", secret.Name, err)
+			}
+			values[uri] = value
+		}
+		env = append(env, secret.Name+"="+value)
+	}
+	return
+}
+
+// Options : The options of Run.
+type Options struct {
+	// The secrets to inject into the environment of the command.
+	Secrets []EnvSecret
+
+	// The program to run and its arguments.
+	Command []string
+
+	// The environment that the secrets are added to. If it is nil, the
+	// environment of the current process is used.
+	Env []string
+
+	// The standard input, output and error of the command.
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// Watch the secrets and, when their values change, restart the command
+	// with the new values or send it ReloadSignal.
+	Watch bool
+
+	// The polling interval of the watched secrets. If it is zero,
+	// secretsmanagerv2.DefaultSecretWatcherInterval is used.
+	WatchInterval time.Duration
+
+	// The signal that is sent to the command when a watched secret changes,
+	// instead of restarting it. The environment of a running process cannot
+	// change, so the command must read the new values by other means, such
+	// as a file rendered by the smtemplate package.
+	ReloadSignal os.Signal
+
+	// The signal that stops the command when it is restarted or when the
+	// context of Run is cancelled. If it is nil, SIGTERM is used.
+	StopSignal os.Signal
+
+	// The time that is given to the command to exit after StopSignal is sent,
+	// before it is killed. If it is zero, DefaultStopTimeout is used.
+	StopTimeout time.Duration
+
+	// The function that receives the errors that occur while the secrets are
+	// watched. They do not stop the command. Errors are dropped if it is nil.
+	OnError func(err error)
+}
+
+// process is a running command.
+type process struct {
+	cmd  *exec.Cmd
+	done chan struct{}
+	err  error
+}
+
+// Run resolves the secrets, runs the command with the secrets added to its
+// environment and returns the exit code of the command once it exits. If
+// "ctx" is cancelled, the command is stopped and the error of the context is
+// returned.
+func Run(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, options *Options) (exitCode int, err error) {
+	if options == nil || len(options.Command) == 0 {
+		return -1, errors.New("the command to run must be specified")
+	}
+	if len(options.Secrets) == 0 {
+		return -1, errors.New("at least one secret must be specified")
+	}
+
+	env, err := ResolveEnv(ctx, client, options.Secrets)
+	if err != nil {
+		return -1, err
+	}
+
+	var updates <-chan *secretsmanagerv2.SecretUpdate
+	if options.Watch {
+		var watcher *secretsmanagerv2.SecretWatcher
+		watcher, err = newWatcher(ctx, client, options)
+		if err != nil {
+			return -1, err
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go watcher.Run(watchCtx)
+		updates = watcher.Updates()
+	}
+
+	child, err := start(options, env)
+	if err != nil {
+		return -1, err
+	}
+	for {
+		select {
+		case <-child.done:
+			return child.exitCode()
+		case <-ctx.Done():
+			stop(options, child)
+			return child.cmd.ProcessState.ExitCode(), ctx.Err()
+		case _, ok := <-updates:
+			if !ok {
+				updates = nil
+				continue
+			}
+			// Updates are delivered for any new version of a secret, so the
+			// command is only reloaded if the values that it uses changed.
+			newEnv, resolveErr := ResolveEnv(ctx, client, options.Secrets)
+			if resolveErr != nil {
+				onError(options, resolveErr)
+				continue
+			}
+			if slices.Equal(newEnv, env) {
+				continue
+			}
+			env = newEnv
+			if options.ReloadSignal != nil {
+				if signalErr := child.cmd.Process.Signal(options.ReloadSignal); signalErr != nil {
+					onError(options, signalErr)
+				}
+				continue
+			}
+			stop(options, child)
+			if child, err = start(options, env); err != nil {
+				return -1, err
+			}
+		}
+	}
+}
+
+// newWatcher returns a watcher of the secrets of the options.
+func newWatcher(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, options *Options) (*secretsmanagerv2.SecretWatcher, error) {
+	var secretIDs []string
+	for _, secret := range options.Secrets {
+		getSecretByNameTypeOptions := client.NewGetSecretByNameTypeOptions(secret.URI.SecretType, secret.URI.Name, secret.URI.SecretGroupName)
+		result, _, err := client.GetSecretByNameTypeWithContext(ctx, getSecretByNameTypeOptions)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", secret.Name, err)
+		}
+		// The secret models do not expose their common properties through
+		// SecretIntf, so the ID is read from the JSON form of the secret.
+		encoded, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		var secretID struct {
+			ID string `json:"id"`
+		}
+		if err = json.Unmarshal(encoded, &secretID); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(secretIDs, secretID.ID) {
+			secretIDs = append(secretIDs, secretID.ID)
+		}
+	}
+	return client.NewSecretWatcher(&secretsmanagerv2.SecretWatcherOptions{
+		SecretIDs: secretIDs,
+		Interval:  options.WatchInterval,
+		OnError: func(secretID string, err error) {
+			onError(options, fmt.Errorf("watching secret '%s': %w", secretID, err))
+		},
+	})
+}
+
+func start(options *Options, env []string) (*process, error) {
+	cmd := exec.Command(options.Command[0], options.Command[1:]...)
+	cmd.Env = options.Env
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	// The secrets are appended last, so they override the variables with the same name.
+	cmd.Env = append(slices.Clip(cmd.Env), env...)
+	cmd.Stdin = options.Stdin
+	cmd.Stdout = options.Stdout
+	cmd.Stderr = options.Stderr
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	child := &process{cmd: cmd, done: make(chan struct{})}
+	go func() {
+		child.err = cmd.Wait()
+		close(child.done)
+	}()
+	return child, nil
+}
+
+// stop sends the stop signal to the command and waits for it to exit. The
+// command is killed if it does not exit within the stop timeout.
+func stop(options *Options, child *process) {
+	stopSignal := options.StopSignal
+	if stopSignal == nil {
+		stopSignal = syscall.SIGTERM
+	}
+	stopTimeout := options.StopTimeout
+	if stopTimeout == 0 {
+		stopTimeout = DefaultStopTimeout
+	}
+
+	if err := child.cmd.Process.Signal(stopSignal); err != nil {
+		_ = child.cmd.Process.Kill()
+	}
+	timer := time.NewTimer(stopTimeout)
+	defer timer.Stop()
+	select {
+	case <-child.done:
+	case <-timer.C:
+		_ = child.cmd.Process.Kill()
+		<-child.done
+	}
+}
+
+// exitCode returns the exit code of a command that exited. A non-zero exit
+// code is not an error.
+func (child *process) exitCode() (int, error) {
+	var exitErr *exec.ExitError
+	if child.err != nil && !errors.As(child.err, &exitErr) {
+		return -1, child.err
+	}
+	return child.cmd.ProcessState.ExitCode(), nil
+}
+
+func onError(options *Options, err error) {
+	if options.OnError != nil {
+		options.OnError(err)
+	}
+}
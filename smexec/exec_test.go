@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smexec_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smexec"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The test binary is also the command that is run by the tests, in the mode
+// of the SMEXEC_TEST_HELPER environment variable.
+func TestMain(m *testing.M) {
+	switch os.Getenv("SMEXEC_TEST_HELPER") {
+	case "":
+		os.Exit(m.Run())
+	case "exit":
+		fmt.Printf("DB_PASS=%s\n", os.Getenv("DB_PASS"))
+		os.Exit(3)
+	case "wait":
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGHUP, syscall.SIGTERM)
+		fmt.Printf("DB_PASS=%s\n", os.Getenv("DB_PASS"))
+		for sig := range signals {
+			if sig == syscall.SIGTERM {
+				os.Exit(0)
+			}
+			fmt.Println("reloaded")
+		}
+	}
+}
+
+// syncBuffer is a bytes.Buffer that can be written by a command while it is read by a test.
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
+
+func newTestClient(t *testing.T) (*secretsmanagerv2.SecretsManagerV2, string) {
+	server := smtest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := server.NewClient()
+	require.Nil(t, err)
+
+	prototype, err := client.NewKVSecretPrototype(secretsmanagerv2.Secret_SecretType_Kv, "db", map[string]interface{}{"password": "first"})
+	require.Nil(t, err)
+	secret, _, err := client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+	return client, *secret.(*secretsmanagerv2.KVSecret).ID
+}
+
+func helperOptions(t *testing.T, mode string, stdout *syncBuffer) *smexec.Options {
+	envSecret, err := smexec.ParseEnvSecret("DB_PASS=kv/default/db#password")
+	require.Nil(t, err)
+	return &smexec.Options{
+		Secrets: []smexec.EnvSecret{*envSecret},
+		Command: []string{os.Args[0]},
+		Env:     append(os.Environ(), "SMEXEC_TEST_HELPER="+mode, "DB_PASS=overridden"),
+		Stdout:  stdout,
+		Stderr:  os.Stderr,
+	}
+}
+
+func TestParseEnvSecret(t *testing.T) {
+	envSecret, err := smexec.ParseEnvSecret("DB_PASS=kv/app/db#password")
+	require.Nil(t, err)
+	assert.Equal(t, "DB_PASS", envSecret.Name)
+	assert.Equal(t, secretsmanagerv2.SecretURI{SecretType: "kv", SecretGroupName: "app", Name: "db", Field: "password"}, *envSecret.URI)
+
+	envSecret, err = smexec.ParseEnvSecret("TOKEN=arbitrary/token")
+	require.Nil(t, err)
+	assert.Equal(t, "ibmsm://default/arbitrary/token", envSecret.URI.String())
+
+	envSecret, err = smexec.ParseEnvSecret("DB_USER=ibmsm://app/username_password/db?version=previous&field=username")
+	require.Nil(t, err)
+	assert.Equal(t, "previous", envSecret.URI.Version)
+
+	for _, value := range []string{"DB_PASS", "=kv/app/db", "DB_PASS=", "DB_PASS=kv", "DB_PASS=kv/app/db/extra", "DB_PASS=kv//db", "DB_PASS=ibmsm://app/kv"} {
+		_, err = smexec.ParseEnvSecret(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestResolveEnv(t *testing.T) {
+	client, _ := newTestClient(t)
+	password, err := smexec.ParseEnvSecret("DB_PASS=kv/db#password")
+	require.Nil(t, err)
+	missing, err := smexec.ParseEnvSecret("DB_USER=kv/db#user")
+	require.Nil(t, err)
+
+	env, err := smexec.ResolveEnv(context.Background(), client, []smexec.EnvSecret{*password, *password})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"DB_PASS=first", "DB_PASS=first"}, env)
+
+	_, err = smexec.ResolveEnv(context.Background(), client, []smexec.EnvSecret{*password, *missing})
+	assert.ErrorContains(t, err, "resolving DB_USER")
+}
+
+func TestRun(t *testing.T) {
+	client, _ := newTestClient(t)
+	var stdout syncBuffer
+
+	exitCode, err := smexec.Run(context.Background(), client, helperOptions(t, "exit", &stdout))
+	require.Nil(t, err)
+	assert.Equal(t, 3, exitCode)
+	assert.Equal(t, "DB_PASS=first\n", stdout.String())
+
+	_, err = smexec.Run(context.Background(), client, &smexec.Options{Command: []string{os.Args[0]}})
+	assert.NotNil(t, err)
+	_, err = smexec.Run(context.Background(), client, &smexec.Options{Secrets: helperOptions(t, "exit", &stdout).Secrets})
+	assert.NotNil(t, err)
+}
+
+func TestRunWatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals are not supported on Windows")
+	}
+	for _, reload := range []bool{false, true} {
+		t.Run(fmt.Sprintf("reload=%t", reload), func(t *testing.T) {
+			client, secretID := newTestClient(t)
+			var stdout syncBuffer
+			options := helperOptions(t, "wait", &stdout)
+			options.Watch = true
+			options.WatchInterval = 10 * time.Millisecond
+			if reload {
+				options.ReloadSignal = syscall.SIGHUP
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			done := make(chan error, 1)
+			go func() {
+				_, err := smexec.Run(ctx, client, options)
+				done <- err
+			}()
+			require.Eventually(t, func() bool { return stdout.String() == "DB_PASS=first\n" }, 5*time.Second, 10*time.Millisecond)
+
+			// Versions that do not change the value do not reload the command.
+			versionPrototype := &secretsmanagerv2.KVSecretVersionPrototype{Data: map[string]interface{}{"password": "first", "other": "value"}}
+			_, _, err := client.CreateSecretVersion(client.NewCreateSecretVersionOptions(secretID, versionPrototype))
+			require.Nil(t, err)
+			time.Sleep(100 * time.Millisecond)
+			assert.Equal(t, "DB_PASS=first\n", stdout.String())
+
+			versionPrototype = &secretsmanagerv2.KVSecretVersionPrototype{Data: map[string]interface{}{"password": "second"}}
+			_, _, err = client.CreateSecretVersion(client.NewCreateSecretVersionOptions(secretID, versionPrototype))
+			require.Nil(t, err)
+			expected := "DB_PASS=first\nDB_PASS=second\n"
+			if reload {
+				expected = "DB_PASS=first\nreloaded\n"
+			}
+			require.Eventually(t, func() bool { return stdout.String() == expected }, 5*time.Second, 10*time.Millisecond)
+
+			cancel()
+			assert.ErrorIs(t, <-done, context.Canceled)
+			assert.False(t, strings.Contains(stdout.String(), "overridden"))
+		})
+	}
+}
+
+func TestRunStopTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals are not supported on Windows")
+	}
+	client, _ := newTestClient(t)
+	var stdout syncBuffer
+	options := helperOptions(t, "wait", &stdout)
+	// SIGHUP does not stop the helper, so it is killed after the stop timeout.
+	options.StopSignal = syscall.SIGHUP
+	options.StopTimeout = 50 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for stdout.String() == "" {
+			time.Sleep(10 * time.Millisecond)
+		}
+		cancel()
+	}()
+	exitCode, err := smexec.Run(ctx, client, options)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, -1, exitCode)
+	assert.Contains(t, stdout.String(), "reloaded")
+}
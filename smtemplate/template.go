@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package smtemplate renders text/template templates that reference secrets
+// in Secrets Manager, for example to produce configuration files.
+//
+// Templates reference a field of a secret with the "secret" function, which
+// takes the secret type, the name of the secret group, the name of the
+// secret and the name of the field:
+//
+//	password: {{ secret "kv" "app" "db" "password" }}
+//	api_key: {{ secret "iam_credentials" "default" "ci" "api_key" }}
+//
+// The field is looked up in the "data" of key-value secrets, and among the
+// JSON fields of the other types of secrets, for example "payload",
+// "username", "password" or "certificate". A dotted field name selects a
+// nested value, for example "credentials.apikey".
+//
+// In dry-run mode the secrets are not retrieved. The "secret" function
+// renders a placeholder instead, and the report lists the secrets that
+// would have been retrieved.
+package smtemplate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// RendererOptions : The options used to construct a Renderer.
+type RendererOptions struct {
+	// Render placeholders instead of retrieving secrets.
+	DryRun bool
+
+	// Additional functions that are available to templates.
+	Funcs template.FuncMap
+}
+
+// SecretReference identifies a secret that is referenced by a template.
+type SecretReference struct {
+	SecretType      string
+	SecretGroupName string
+	Name            string
+}
+
+// String returns "<secret_group_name>/<secret_type>/<name>".
+func (ref SecretReference) String() string {
+	return fmt.Sprintf("%s/%s/%s", ref.SecretGroupName, ref.SecretType, ref.Name)
+}
+
+// ReportEntry : A secret that was referenced by a template.
+type ReportEntry struct {
+	SecretReference
+
+	// The fields of the secret that were referenced, in alphabetical order.
+	Fields []string
+
+	// Whether the secret was retrieved. It is false in dry-run mode.
+	Retrieved bool
+}
+
+// Report : The secrets that were referenced while a template was rendered.
+type Report struct {
+	// Whether the template was rendered in dry-run mode.
+	DryRun bool
+
+	// The referenced secrets, ordered by their string representation.
+	Secrets []ReportEntry
+}
+
+// Renderer renders templates that reference secrets.
+type Renderer struct {
+	client secretsmanagerv2.SecretsManagerV2Intf
+	dryRun bool
+	funcs  template.FuncMap
+}
+
+// NewRenderer returns a new Renderer that retrieves secrets with "client".
+func NewRenderer(client secretsmanagerv2.SecretsManagerV2Intf, options *RendererOptions) (*Renderer, error) {
+	if client == nil {
+		return nil, fmt.Errorf("the client must not be nil")
+	}
+	if options == nil {
+		options = &RendererOptions{}
+	}
+	return &Renderer{
+		client: client,
+		dryRun: options.DryRun,
+		funcs:  options.Funcs,
+	}, nil
+}
+
+// render holds the state of one rendering: the secrets that were retrieved
+// and the fields that were referenced.
+type render struct {
+	renderer *Renderer
+	ctx      context.Context
+	secrets  map[SecretReference]map[string]any
+	fields   map[SecretReference]map[string]bool
+}
+
+// Render parses "text" as a template named "name", renders it to "w" and
+// returns the secrets that it referenced. Each secret is retrieved once,
+// however many times it is referenced.
+func (renderer *Renderer) Render(ctx context.Context, w io.Writer, name string, text string) (report *Report, err error) {
+	r := &render{
+		renderer: renderer,
+		ctx:      ctx,
+		secrets:  map[SecretReference]map[string]any{},
+		fields:   map[SecretReference]map[string]bool{},
+	}
+	tmpl := template.New(name).Option("missingkey=error")
+	if renderer.funcs != nil {
+		tmpl = tmpl.Funcs(renderer.funcs)
+	}
+	tmpl, err = tmpl.Funcs(template.FuncMap{"secret": r.secret}).Parse(text)
+	if err != nil {
+		return
+	}
+	// The output is buffered so that nothing is written if a secret cannot be resolved.
+	var output bytes.Buffer
+	if err = tmpl.Execute(&output, nil); err != nil {
+		return
+	}
+	if _, err = output.WriteTo(w); err != nil {
+		return
+	}
+	return r.report(), nil
+}
+
+// RenderFile renders the template in the file "templatePath" to the file
+// "outputPath". The output file is replaced atomically and is only readable
+// by its owner. In dry-run mode the output file is not written.
+func (renderer *Renderer) RenderFile(ctx context.Context, templatePath string, outputPath string) (report *Report, err error) {
+	text, err := os.ReadFile(templatePath)
+	if err != nil {
+		return
+	}
+	var output bytes.Buffer
+	report, err = renderer.Render(ctx, &output, filepath.Base(templatePath), string(text))
+	if err != nil || renderer.dryRun {
+		return
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tempFile.Name())
+	if _, err = output.WriteTo(tempFile); err != nil {
+		tempFile.Close()
+		return nil, err
+	}
+	if err = tempFile.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(tempFile.Name(), outputPath); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// secret is the "secret" template function.
+func (r *render) secret(secretType string, secretGroupName string, name string, field string) (string, error) {
+	ref := SecretReference{SecretType: secretType, SecretGroupName: secretGroupName, Name: name}
+	if r.fields[ref] == nil {
+		r.fields[ref] = map[string]bool{}
+	}
+	r.fields[ref][field] = true
+	if r.renderer.dryRun {
+		return fmt.Sprintf("<%s#%s>", ref, field), nil
+	}
+
+	fields, ok := r.secrets[ref]
+	if !ok {
+		getSecretByNameTypeOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{
+			SecretType:      &ref.SecretType,
+			Name:            &ref.Name,
+			SecretGroupName: &ref.SecretGroupName,
+		}
+		secret, _, err := r.renderer.client.GetSecretByNameTypeWithContext(r.ctx, getSecretByNameTypeOptions)
+		if err != nil {
+			return "", fmt.Errorf("retrieving secret '%s': %w", ref, err)
+		}
+		if fields, err = secretFields(secret); err != nil {
+			return "", fmt.Errorf("reading secret '%s': %w", ref, err)
+		}
+		r.secrets[ref] = fields
+	}
+
+	value, ok := lookupField(fields, secretType, field)
+	if !ok {
+		return "", fmt.Errorf("secret '%s' has no field '%s'", ref, field)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	// Structured values are rendered as JSON.
+	encoded, err := json.Marshal(value)
+	return string(encoded), err
+}
+
+func (r *render) report() *Report {
+	report := &Report{DryRun: r.renderer.dryRun}
+	for ref, fields := range r.fields {
+		entry := ReportEntry{SecretReference: ref}
+		for field := range fields {
+			entry.Fields = append(entry.Fields, field)
+		}
+		sort.Strings(entry.Fields)
+		_, entry.Retrieved = r.secrets[ref]
+		report.Secrets = append(report.Secrets, entry)
+	}
+	sort.Slice(report.Secrets, func(i, j int) bool {
+		return report.Secrets[i].String() < report.Secrets[j].String()
+	})
+	return report
+}
+
+// secretFields returns the JSON fields of a secret.
+func secretFields(secret secretsmanagerv2.SecretIntf) (fields map[string]any, err error) {
+	encoded, err := json.Marshal(secret)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(encoded, &fields)
+	return
+}
+
+// lookupField returns the value of a dotted field name. The fields of
+// key-value secrets are looked up in their "data".
+func lookupField(fields map[string]any, secretType string, field string) (value any, ok bool) {
+	value = fields
+	if secretType == secretsmanagerv2.Secret_SecretType_Kv {
+		value = fields["data"]
+	}
+	for _, key := range strings.Split(field, ".") {
+		object, isObject := value.(map[string]any)
+		if !isObject {
+			return nil, false
+		}
+		if value, ok = object[key]; !ok {
+			return
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtemplate_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtemplate"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configTemplate = `db:
+  user: {{ secret "kv" "default" "db" "user" }}
+  password: {{ secret "kv" "default" "db" "password" }}
+  options: {{ secret "kv" "default" "db" "options" }}
+  host: {{ secret "kv" "default" "db" "options.host" }}
+token: {{ secret "arbitrary" "default" "token" "payload" | upper }}
+`
+
+func newTestServer(t *testing.T) *secretsmanagerv2.SecretsManagerV2 {
+	server := smtest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := server.NewClient()
+	require.Nil(t, err)
+
+	kvPrototype, err := client.NewKVSecretPrototype(secretsmanagerv2.Secret_SecretType_Kv, "db", map[string]interface{}{
+		"user":     "admin",
+		"password": "s3cr3t",
+		"options":  map[string]interface{}{"host": "db.example.com"},
+	})
+	require.Nil(t, err)
+	_, _, err = client.CreateSecret(client.NewCreateSecretOptions(kvPrototype))
+	require.Nil(t, err)
+
+	arbitraryPrototype, err := client.NewArbitrarySecretPrototype("token", secretsmanagerv2.Secret_SecretType_Arbitrary, "abc")
+	require.Nil(t, err)
+	_, _, err = client.CreateSecret(client.NewCreateSecretOptions(arbitraryPrototype))
+	require.Nil(t, err)
+	return client
+}
+
+func TestRender(t *testing.T) {
+	client := newTestServer(t)
+	renderer, err := smtemplate.NewRenderer(client, &smtemplate.RendererOptions{
+		Funcs: template.FuncMap{"upper": strings.ToUpper},
+	})
+	require.Nil(t, err)
+
+	var output bytes.Buffer
+	report, err := renderer.Render(context.Background(), &output, "config", configTemplate)
+	require.Nil(t, err)
+	assert.Equal(t, `db:
+  user: admin
+  password: s3cr3t
+  options: {"host":"db.example.com"}
+  host: db.example.com
+token: ABC
+`, output.String())
+
+	assert.False(t, report.DryRun)
+	require.Len(t, report.Secrets, 2)
+	assert.Equal(t, "default/arbitrary/token", report.Secrets[0].String())
+	assert.Equal(t, []string{"payload"}, report.Secrets[0].Fields)
+	assert.Equal(t, "default/kv/db", report.Secrets[1].String())
+	assert.Equal(t, []string{"options", "options.host", "password", "user"}, report.Secrets[1].Fields)
+	assert.True(t, report.Secrets[1].Retrieved)
+}
+
+func TestRenderRetrievesEachSecretOnce(t *testing.T) {
+	mock := &smtest.MockSecretsManagerV2{}
+	mock.GetSecretByNameTypeFunc = func(ctx context.Context, options *secretsmanagerv2.GetSecretByNameTypeOptions) (secretsmanagerv2.SecretIntf, *core.DetailedResponse, error) {
+		return &secretsmanagerv2.UsernamePasswordSecret{
+			Username: core.StringPtr("admin"),
+			Password: core.StringPtr("s3cr3t"),
+		}, nil, nil
+	}
+	renderer, err := smtemplate.NewRenderer(mock, nil)
+	require.Nil(t, err)
+
+	var output bytes.Buffer
+	_, err = renderer.Render(context.Background(), &output, "config",
+		`{{ secret "username_password" "app" "db" "username" }}:{{ secret "username_password" "app" "db" "password" }}`)
+	require.Nil(t, err)
+	assert.Equal(t, "admin:s3cr3t", output.String())
+
+	calls := mock.CallsTo("GetSecretByNameType")
+	require.Len(t, calls, 1)
+	options := calls[0].(*secretsmanagerv2.GetSecretByNameTypeOptions)
+	assert.Equal(t, "app", *options.SecretGroupName)
+	assert.Equal(t, "db", *options.Name)
+}
+
+func TestRenderDryRun(t *testing.T) {
+	mock := &smtest.MockSecretsManagerV2{}
+	renderer, err := smtemplate.NewRenderer(mock, &smtemplate.RendererOptions{
+		DryRun: true,
+		Funcs:  template.FuncMap{"upper": strings.ToUpper},
+	})
+	require.Nil(t, err)
+
+	var output bytes.Buffer
+	report, err := renderer.Render(context.Background(), &output, "config", configTemplate)
+	require.Nil(t, err)
+	assert.Contains(t, output.String(), "password: <default/kv/db#password>")
+	assert.Empty(t, mock.Calls())
+
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Secrets, 2)
+	assert.False(t, report.Secrets[0].Retrieved)
+	assert.False(t, report.Secrets[1].Retrieved)
+}
+
+func TestRenderErrors(t *testing.T) {
+	client := newTestServer(t)
+	renderer, err := smtemplate.NewRenderer(client, nil)
+	require.Nil(t, err)
+
+	var output bytes.Buffer
+	_, err = renderer.Render(context.Background(), &output, "config", `a {{ secret "kv" "default" "db" "missing" }}`)
+	assert.ErrorContains(t, err, "secret 'default/kv/db' has no field 'missing'")
+	assert.Empty(t, output.String())
+
+	_, err = renderer.Render(context.Background(), &output, "config", `{{ secret "kv" "default" "unknown" "user" }}`)
+	assert.ErrorContains(t, err, "retrieving secret 'default/kv/unknown'")
+
+	_, err = renderer.Render(context.Background(), &output, "config", `{{ secret "kv" }}`)
+	assert.NotNil(t, err)
+
+	_, err = smtemplate.NewRenderer(nil, nil)
+	assert.NotNil(t, err)
+}
+
+func TestRenderFile(t *testing.T) {
+	client := newTestServer(t)
+	dir := t.TempDir()
+	templatePath := filepath.Join(dir, "config.yaml.tmpl")
+	outputPath := filepath.Join(dir, "config.yaml")
+	require.Nil(t, os.WriteFile(templatePath, []byte(`password: {{ secret "kv" "default" "db" "password" }}`), 0644))
+
+	dryRunRenderer, err := smtemplate.NewRenderer(client, &smtemplate.RendererOptions{DryRun: true})
+	require.Nil(t, err)
+	report, err := dryRunRenderer.RenderFile(context.Background(), templatePath, outputPath)
+	require.Nil(t, err)
+	require.Len(t, report.Secrets, 1)
+	assert.NoFileExists(t, outputPath)
+
+	renderer, err := smtemplate.NewRenderer(client, nil)
+	require.Nil(t, err)
+	_, err = renderer.RenderFile(context.Background(), templatePath, outputPath)
+	require.Nil(t, err)
+	content, err := os.ReadFile(outputPath)
+	require.Nil(t, err)
+	assert.Equal(t, "password: s3cr3t", string(content))
+	info, err := os.Stat(outputPath)
+	require.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// defaultCertificateTTL is the validity of the certificates that are issued
+// when the secret does not specify a "ttl".
+const defaultCertificateTTL = 90 * 24 * time.Hour
+
+// issueCertificate issues a self-signed certificate for the "common_name"
+// and "alt_names" of a secret. It returns the payload fields of the
+// certificate and the description of the certificate.
+func issueCertificate(fields map[string]any) (payload map[string]any, description map[string]any, err error) {
+	ttl := defaultCertificateTTL
+	if value, ok := fields["ttl"]; ok {
+		if ttl, err = parseTTL(fmt.Sprint(value)); err != nil {
+			return nil, nil, badRequest("The ttl '%v' is not valid.", value)
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return
+	}
+	notBefore := time.Now().UTC().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: stringField(fields, "common_name")},
+		DNSNames:     append([]string{stringField(fields, "common_name")}, stringList(fields["alt_names"])...),
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(ttl),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return
+	}
+	certificate, err := x509.ParseCertificate(certificateDER)
+	if err != nil {
+		return
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return
+	}
+
+	certificatePEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER}))
+	payload = map[string]any{
+		"certificate": certificatePEM,
+		"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})),
+		"issuing_ca":  certificatePEM,
+		"ca_chain":    []string{certificatePEM},
+	}
+	return payload, describeCertificate(certificate), nil
+}
+
+// signCertificateRequest signs the "csr" of a sign CSR action with a
+// self-signed certificate authority that is generated for each request and
+// named after the "common_name" of the configuration. The certificate has the
+// subject and the alternative names of the CSR, and the "ttl" of the action.
+// It returns the "data" of the action.
+func signCertificateRequest(config map[string]any, action map[string]any) (data map[string]any, err error) {
+	block, _ := pem.Decode([]byte(stringField(action, "csr")))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, badRequest("The field 'csr' must be a PEM encoded certificate signing request.")
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil || request.CheckSignature() != nil {
+		return nil, badRequest("The certificate signing request is not valid.")
+	}
+	ttl := defaultCertificateTTL
+	if value, ok := action["ttl"]; ok {
+		if ttl, err = parseTTL(fmt.Sprint(value)); err != nil {
+			return nil, badRequest("The ttl '%v' is not valid.", value)
+		}
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return
+	}
+	notBefore := time.Now().UTC().Truncate(time.Second)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: stringField(config, "common_name")},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(ttl),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber:   serialNumber,
+		Subject:        request.Subject,
+		DNSNames:       request.DNSNames,
+		EmailAddresses: request.EmailAddresses,
+		IPAddresses:    request.IPAddresses,
+		URIs:           request.URIs,
+		NotBefore:      notBefore,
+		NotAfter:       notBefore.Add(ttl),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, request.PublicKey, caKey)
+	if err != nil {
+		return
+	}
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+	data = map[string]any{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER})),
+		"issuing_ca":  caPEM,
+		"ca_chain":    []string{caPEM},
+		"expiration":  template.NotAfter.Unix(),
+	}
+	return data, nil
+}
+
+// describeCertificatePEM returns the description of the first certificate in "certificatePEM".
+func describeCertificatePEM(certificatePEM string) (map[string]any, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate was found")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return describeCertificate(certificate), nil
+}
+
+// describeCertificate returns the metadata fields that describe a certificate.
+func describeCertificate(certificate *x509.Certificate) map[string]any {
+	serialNumber := certificate.SerialNumber.Text(16)
+	if len(serialNumber)%2 == 1 {
+		serialNumber = "0" + serialNumber
+	}
+	var serialParts []string
+	for i := 0; i < len(serialNumber); i += 2 {
+		serialParts = append(serialParts, serialNumber[i:i+2])
+	}
+
+	keyAlgorithm := certificate.PublicKeyAlgorithm.String()
+	switch key := certificate.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		keyAlgorithm = fmt.Sprintf("EC%d", key.Curve.Params().BitSize)
+	case interface{ Size() int }:
+		keyAlgorithm = fmt.Sprintf("%s%d", keyAlgorithm, key.Size()*8)
+	}
+
+	description := map[string]any{
+		"common_name":       certificate.Subject.CommonName,
+		"issuer":            certificate.Issuer.String(),
+		"serial_number":     strings.Join(serialParts, ":"),
+		"signing_algorithm": certificate.SignatureAlgorithm.String(),
+		"key_algorithm":     keyAlgorithm,
+		"expiration_date":   strfmt.DateTime(certificate.NotAfter),
+		"validity": map[string]any{
+			"not_before": strfmt.DateTime(certificate.NotBefore),
+			"not_after":  strfmt.DateTime(certificate.NotAfter),
+		},
+	}
+	if len(certificate.DNSNames) > 0 {
+		description["alt_names"] = certificate.DNSNames
+	}
+	return description
+}
+
+// parseTTL parses a time to live that is expressed in seconds, or as a
+// duration with an "s", "m", "h" or "d" unit.
+func parseTTL(ttl string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(ttl); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if days, ok := strings.CutSuffix(ttl, "d"); ok {
+		count, err := strconv.Atoi(days)
+		return time.Duration(count) * 24 * time.Hour, err
+	}
+	return time.ParseDuration(ttl)
+}
@@ -0,0 +1,277 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// configurationTypes maps each "config_type" to the secret type that it
+// configures and to the fields that are omitted when configurations are listed.
+var configurationTypes = map[string]struct {
+	secretType    string
+	privateFields []string
+}{
+	secretsmanagerv2.Configuration_ConfigType_CustomCredentialsConfiguration: {
+		secretsmanagerv2.Secret_SecretType_CustomCredentials,
+		privateFields(secretsmanagerv2.CustomCredentialsConfiguration{}, secretsmanagerv2.CustomCredentialsConfigurationMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_IamCredentialsConfiguration: {
+		secretsmanagerv2.Secret_SecretType_IamCredentials,
+		privateFields(secretsmanagerv2.IAMCredentialsConfiguration{}, secretsmanagerv2.IAMCredentialsConfigurationMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationIntermediateCa: {
+		secretsmanagerv2.Secret_SecretType_PrivateCert,
+		privateFields(secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA{}, secretsmanagerv2.PrivateCertificateConfigurationIntermediateCAMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationRootCa: {
+		secretsmanagerv2.Secret_SecretType_PrivateCert,
+		privateFields(secretsmanagerv2.PrivateCertificateConfigurationRootCA{}, secretsmanagerv2.PrivateCertificateConfigurationRootCAMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationTemplate: {
+		secretsmanagerv2.Secret_SecretType_PrivateCert,
+		privateFields(secretsmanagerv2.PrivateCertificateConfigurationTemplate{}, secretsmanagerv2.PrivateCertificateConfigurationTemplateMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PublicCertConfigurationCaLetsEncrypt: {
+		secretsmanagerv2.Secret_SecretType_PublicCert,
+		privateFields(secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt{}, secretsmanagerv2.PublicCertificateConfigurationCALetsEncryptMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PublicCertConfigurationDnsClassicInfrastructure: {
+		secretsmanagerv2.Secret_SecretType_PublicCert,
+		privateFields(secretsmanagerv2.PublicCertificateConfigurationDNSClassicInfrastructure{}, secretsmanagerv2.PublicCertificateConfigurationDNSClassicInfrastructureMetadata{}),
+	},
+	secretsmanagerv2.Configuration_ConfigType_PublicCertConfigurationDnsCloudInternetServices: {
+		secretsmanagerv2.Secret_SecretType_PublicCert,
+		privateFields(secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices{}, secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServicesMetadata{}),
+	},
+}
+
+// configurationActionTypes lists the actions that can be run on each type of configuration.
+var configurationActionTypes = map[string][]string{
+	secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationRootCa: {
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRevokeCaCertificate,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRotateCrl,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSignCsr,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSignIntermediate,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRotateIntermediate,
+	},
+	secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationIntermediateCa: {
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRevokeCaCertificate,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRotateCrl,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSetSigned,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSignCsr,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSignIntermediate,
+		secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionRotateIntermediate,
+	},
+}
+
+type configurationRecord struct {
+	fields map[string]any
+}
+
+// metadata returns the fields of the configuration that are included when configurations are listed.
+func (config *configurationRecord) metadata() map[string]any {
+	return copyFields(config.fields, configurationTypes[stringField(config.fields, "config_type")].privateFields...)
+}
+
+func (server *Server) findConfiguration(name string) (int, *configurationRecord) {
+	for i, config := range server.configs {
+		if stringField(config.fields, "name") == name {
+			return i, config
+		}
+	}
+	return -1, nil
+}
+
+func (server *Server) lookupConfiguration(req *http.Request) (int, *configurationRecord, error) {
+	name := req.PathValue("name")
+	index, config := server.findConfiguration(name)
+	if config == nil {
+		return 0, nil, notFound("The configuration '%s' could not be found.", name)
+	}
+	if configType := req.Header.Get("X-Sm-Accept-Configuration-Type"); configType != "" && configType != stringField(config.fields, "config_type") {
+		return 0, nil, notFound("The configuration '%s' of type '%s' could not be found.", name, configType)
+	}
+	return index, config, nil
+}
+
+func (server *Server) createConfiguration(req *http.Request) (int, any, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	configType := stringField(body, "config_type")
+	configTypeInfo, ok := configurationTypes[configType]
+	if !ok {
+		return 0, nil, badRequest("The configuration type '%s' is not valid.", configType)
+	}
+	name := stringField(body, "name")
+	if !namePattern.MatchString(name) {
+		return 0, nil, badRequest("The configuration name '%s' is not valid.", name)
+	}
+	if _, existing := server.findConfiguration(name); existing != nil {
+		return 0, nil, conflict("A configuration with the name '%s' already exists.", name)
+	}
+
+	now := timestamp()
+	config := &configurationRecord{fields: body}
+	config.fields["secret_type"] = configTypeInfo.secretType
+	config.fields["created_by"] = CreatedBy
+	config.fields["created_at"] = now
+	config.fields["updated_at"] = now
+	switch configType {
+	case secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationRootCa:
+		config.fields["status"] = secretsmanagerv2.Configuration_Status_Configured
+	case secretsmanagerv2.Configuration_ConfigType_PrivateCertConfigurationIntermediateCa:
+		// An intermediate CA must be signed by a root CA before it can issue certificates.
+		config.fields["status"] = secretsmanagerv2.Configuration_Status_SigningRequired
+	}
+	server.configs = append(server.configs, config)
+	return http.StatusCreated, config.fields, nil
+}
+
+func (server *Server) listConfigurations(req *http.Request) (int, any, error) {
+	secretTypes := queryList(req, "secret_types")
+	search := req.URL.Query().Get("search")
+	configs := []map[string]any{}
+	for _, config := range server.configs {
+		if len(secretTypes) > 0 && !slices.Contains(secretTypes, stringField(config.fields, "secret_type")) {
+			continue
+		}
+		if search != "" && !strings.Contains(stringField(config.fields, "name"), search) {
+			continue
+		}
+		configs = append(configs, config.metadata())
+	}
+	if err := sortByField(configs, req.URL.Query().Get("sort"), "config_type", "secret_type", "name", "created_at", "updated_at"); err != nil {
+		return 0, nil, err
+	}
+	result, err := paginate(req, "configurations", configs)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, result, nil
+}
+
+func (server *Server) getConfiguration(req *http.Request) (int, any, error) {
+	_, config, err := server.lookupConfiguration(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, config.fields, nil
+}
+
+func (server *Server) updateConfiguration(req *http.Request) (int, any, error) {
+	_, config, err := server.lookupConfiguration(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	err = mergePatch(config.fields, body, "name", "config_type", "secret_type", "created_by", "created_at", "updated_at", "status")
+	if err != nil {
+		return 0, nil, err
+	}
+	config.fields["updated_at"] = timestamp()
+	return http.StatusOK, config.fields, nil
+}
+
+func (server *Server) deleteConfiguration(req *http.Request) (int, any, error) {
+	index, config, err := server.lookupConfiguration(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	name := stringField(config.fields, "name")
+	for _, secret := range server.secrets {
+		for _, field := range []string{"configuration", "certificate_template", "ca", "dns"} {
+			if stringField(secret.fields, field) == name {
+				return 0, nil, preconditionFailed("The configuration '%s' cannot be deleted because it is used by secret '%s'.", name, stringField(secret.fields, "id"))
+			}
+		}
+	}
+	server.configs = slices.Delete(server.configs, index, index+1)
+	return http.StatusNoContent, nil, nil
+}
+
+func (server *Server) createConfigurationAction(req *http.Request) (int, any, error) {
+	_, config, err := server.lookupConfiguration(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	actionType := stringField(body, "action_type")
+	if !slices.Contains(configurationActionTypes[stringField(config.fields, "config_type")], actionType) {
+		return 0, nil, badRequest("The action '%s' is not supported by the configuration '%s'.", actionType, stringField(config.fields, "name"))
+	}
+	if actionType == secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSetSigned {
+		config.fields["status"] = secretsmanagerv2.Configuration_Status_Configured
+		config.fields["updated_at"] = timestamp()
+	}
+	if actionType == secretsmanagerv2.ConfigurationAction_ActionType_PrivateCertConfigurationActionSignCsr {
+		if body["data"], err = signCertificateRequest(config.fields, body); err != nil {
+			return 0, nil, err
+		}
+	}
+	return http.StatusCreated, body, nil
+}
+
+func (server *Server) createNotificationsRegistration(req *http.Request) (int, any, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	if server.notifications != nil {
+		return 0, nil, conflict("The instance is already registered with Event Notifications.")
+	}
+	crn := stringField(body, "event_notifications_instance_crn")
+	if crn == "" {
+		return 0, nil, badRequest("The field 'event_notifications_instance_crn' is required.")
+	}
+	server.notifications = map[string]any{"event_notifications_instance_crn": crn}
+	return http.StatusCreated, server.notifications, nil
+}
+
+func (server *Server) getNotificationsRegistration(req *http.Request) (int, any, error) {
+	if server.notifications == nil {
+		return 0, nil, notFound("The instance is not registered with Event Notifications.")
+	}
+	return http.StatusOK, server.notifications, nil
+}
+
+func (server *Server) deleteNotificationsRegistration(req *http.Request) (int, any, error) {
+	if server.notifications == nil {
+		return 0, nil, notFound("The instance is not registered with Event Notifications.")
+	}
+	server.notifications = nil
+	return http.StatusNoContent, nil, nil
+}
+
+func (server *Server) testNotificationsRegistration(req *http.Request) (int, any, error) {
+	if server.notifications == nil {
+		return 0, nil, notFound("The instance is not registered with Event Notifications.")
+	}
+	return http.StatusNoContent, nil, nil
+}
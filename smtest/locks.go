@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// lockDetails returns a lock of a version of the secret, as it is returned by the service.
+func (secret *secretRecord) lockDetails(version *versionRecord, lock map[string]any) map[string]any {
+	result := copyFields(lock)
+	result["secret_id"] = secret.fields["id"]
+	result["secret_group_id"] = secret.fields["secret_group_id"]
+	result["secret_version_id"] = version.fields["id"]
+	result["secret_version_alias"] = secret.alias(version)
+	return result
+}
+
+// locksSummary returns the names of the locks of each locked version of the secret.
+func (secret *secretRecord) locksSummary() map[string]any {
+	versions := []map[string]any{}
+	for i := len(secret.versions) - 1; i >= 0; i-- {
+		version := secret.versions[i]
+		if len(version.locks) == 0 {
+			continue
+		}
+		names := make([]string, 0, len(version.locks))
+		for _, lock := range version.locks {
+			names = append(names, stringField(lock, "name"))
+		}
+		versions = append(versions, map[string]any{
+			"version_id":        version.fields["id"],
+			"version_alias":     secret.alias(version),
+			"locks":             names,
+			"payload_available": version.payload != nil,
+		})
+	}
+	return map[string]any{
+		"secret_id":       secret.fields["id"],
+		"secret_group_id": secret.fields["secret_group_id"],
+		"secret_type":     secret.fields["secret_type"],
+		"secret_name":     secret.fields["name"],
+		"versions":        versions,
+	}
+}
+
+func (server *Server) listSecretsLocks(req *http.Request) (int, any, error) {
+	groups := queryList(req, "groups")
+	search := req.URL.Query().Get("search")
+	secretsLocks := []map[string]any{}
+	for _, secret := range server.secrets {
+		if secret.locksTotal() == 0 {
+			continue
+		}
+		if len(groups) > 0 && !slices.Contains(groups, stringField(secret.fields, "secret_group_id")) {
+			continue
+		}
+		if search != "" && !strings.Contains(stringField(secret.fields, "name"), search) {
+			continue
+		}
+		secretsLocks = append(secretsLocks, secret.locksSummary())
+	}
+	result, err := paginate(req, "secrets_locks", secretsLocks)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, result, nil
+}
+
+func (server *Server) listSecretLocks(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return listLocks(req, secret, secret.versions)
+}
+
+func (server *Server) listSecretVersionLocks(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return listLocks(req, secret, []*versionRecord{version})
+}
+
+func listLocks(req *http.Request, secret *secretRecord, versions []*versionRecord) (int, any, error) {
+	search := req.URL.Query().Get("search")
+	locks := []map[string]any{}
+	for _, version := range versions {
+		for _, lock := range version.locks {
+			if search != "" && !strings.Contains(stringField(lock, "name"), search) {
+				continue
+			}
+			locks = append(locks, secret.lockDetails(version, lock))
+		}
+	}
+	if err := sortByField(locks, req.URL.Query().Get("sort"), "name", "created_at", "updated_at"); err != nil {
+		return 0, nil, err
+	}
+	result, err := paginate(req, "locks", locks)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, result, nil
+}
+
+func (server *Server) createSecretLocksBulk(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return server.createLocks(req, secret, secret.current())
+}
+
+func (server *Server) createSecretVersionLocksBulk(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return server.createLocks(req, secret, version)
+}
+
+// createLocks adds the locks in the body of the request to a version of the
+// secret. A lock that has the name of an existing lock of the version replaces it.
+func (server *Server) createLocks(req *http.Request, secret *secretRecord, version *versionRecord) (int, any, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	prototypes, _ := body["locks"].([]any)
+	if len(prototypes) == 0 {
+		return 0, nil, badRequest("The field 'locks' must contain at least one lock.")
+	}
+	mode := req.URL.Query().Get("mode")
+	if mode != "" && mode != secretsmanagerv2.CreateSecretLocksBulkOptions_Mode_RemovePrevious && mode != secretsmanagerv2.CreateSecretLocksBulkOptions_Mode_RemovePreviousAndDelete {
+		return 0, nil, badRequest("The lock mode '%s' is not valid.", mode)
+	}
+	if version.payload == nil && secret.alias(version) != secretsmanagerv2.SecretVersionMetadata_Alias_Current {
+		return 0, nil, preconditionFailed("The version '%s' of secret '%s' cannot be locked because its data was deleted.", version.fields["id"], secret.fields["id"])
+	}
+
+	now := timestamp()
+	var names []string
+	for _, prototype := range prototypes {
+		prototype, _ := prototype.(map[string]any)
+		name := stringField(prototype, "name")
+		if !namePattern.MatchString(name) {
+			return 0, nil, badRequest("The lock name '%s' is not valid.", name)
+		}
+		lock := map[string]any{
+			"name":       name,
+			"created_by": CreatedBy,
+			"created_at": now,
+			"updated_at": now,
+		}
+		for _, field := range []string{"description", "attributes"} {
+			if prototype[field] != nil {
+				lock[field] = prototype[field]
+			}
+		}
+		index := slices.IndexFunc(version.locks, func(existing map[string]any) bool { return stringField(existing, "name") == name })
+		if index >= 0 {
+			lock["created_at"] = version.locks[index]["created_at"]
+			version.locks[index] = lock
+		} else {
+			version.locks = append(version.locks, lock)
+		}
+		names = append(names, name)
+	}
+
+	if previous := secret.previous(); mode != "" && previous != nil && version == secret.current() {
+		previous.locks = slices.DeleteFunc(previous.locks, func(lock map[string]any) bool {
+			return slices.Contains(names, stringField(lock, "name"))
+		})
+		if mode == secretsmanagerv2.CreateSecretLocksBulkOptions_Mode_RemovePreviousAndDelete && len(previous.locks) == 0 {
+			previous.payload = nil
+		}
+	}
+	secret.fields["updated_at"] = now
+	return http.StatusCreated, secret.locksSummary(), nil
+}
+
+func (server *Server) deleteSecretLocksBulk(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return deleteLocks(req, secret, secret.versions)
+}
+
+func (server *Server) deleteSecretVersionLocksBulk(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return deleteLocks(req, secret, []*versionRecord{version})
+}
+
+// deleteLocks deletes the locks that are named in the "name" query
+// parameter from the versions of the secret, or all their locks if no name is specified.
+func deleteLocks(req *http.Request, secret *secretRecord, versions []*versionRecord) (int, any, error) {
+	names := queryList(req, "name")
+	for _, version := range versions {
+		version.locks = slices.DeleteFunc(version.locks, func(lock map[string]any) bool {
+			return len(names) == 0 || slices.Contains(names, stringField(lock, "name"))
+		})
+	}
+	secret.fields["updated_at"] = timestamp()
+	return http.StatusOK, secret.locksSummary(), nil
+}
@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+//go:generate go run ../internal/mockgen -source ../secretsmanagerv2/secrets_manager_v2.go -interface ../secretsmanagerv2/secrets_manager_v2_intf.go -mock mock_secrets_manager_v2.go -router ../secretsmanagerv2/secrets_manager_v2_router.go -routes ../secretsmanagerv2/secrets_manager_v2_routes.go
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/secrets-manager-go-sdk/v2/common"
+)
+
+// MockCall is an operation that was called on a MockSecretsManagerV2.
+type MockCall struct {
+	// The name of the operation, for example "GetSecret".
+	Operation string
+
+	// The options that were passed to the operation.
+	Options any
+}
+
+type mockCalls struct {
+	mu    sync.Mutex
+	calls []MockCall
+}
+
+func (c *mockCalls) record(operation string, options any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, MockCall{Operation: operation, Options: options})
+}
+
+// Calls returns the operations that were called on the mock, in order.
+func (mock *MockSecretsManagerV2) Calls() []MockCall {
+	mock.calls.mu.Lock()
+	defer mock.calls.mu.Unlock()
+	return append([]MockCall(nil), mock.calls.calls...)
+}
+
+// CallsTo returns the options of the calls to an operation, in order.
+func (mock *MockSecretsManagerV2) CallsTo(operation string) (options []any) {
+	for _, call := range mock.Calls() {
+		if call.Operation == operation {
+			options = append(options, call.Options)
+		}
+	}
+	return
+}
+
+func errNotMocked(operation string) error {
+	return core.SDKErrorf(nil, fmt.Sprintf("the operation '%s' is not mocked", operation), "operation-not-mocked", common.GetComponentInfo())
+}
@@ -0,0 +1,694 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package smtest
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// MockSecretsManagerV2 is a mock implementation of secretsmanagerv2.SecretsManagerV2Intf.
+//
+// Each operation is implemented by the function field that is named after it with a "Func" suffix. The
+// plain variant of an operation calls the same function as its "WithContext" variant, with
+// context.Background(). Operations without a function return an error. All calls are recorded.
+type MockSecretsManagerV2 struct {
+	CreateSecretGroupFunc                func(ctx context.Context, createSecretGroupOptions *secretsmanagerv2.CreateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error)
+	ListSecretGroupsFunc                 func(ctx context.Context, listSecretGroupsOptions *secretsmanagerv2.ListSecretGroupsOptions) (result *secretsmanagerv2.SecretGroupCollection, response *core.DetailedResponse, err error)
+	GetSecretGroupFunc                   func(ctx context.Context, getSecretGroupOptions *secretsmanagerv2.GetSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error)
+	UpdateSecretGroupFunc                func(ctx context.Context, updateSecretGroupOptions *secretsmanagerv2.UpdateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error)
+	DeleteSecretGroupFunc                func(ctx context.Context, deleteSecretGroupOptions *secretsmanagerv2.DeleteSecretGroupOptions) (response *core.DetailedResponse, err error)
+	CreateSecretFunc                     func(ctx context.Context, createSecretOptions *secretsmanagerv2.CreateSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error)
+	ListSecretsFunc                      func(ctx context.Context, listSecretsOptions *secretsmanagerv2.ListSecretsOptions) (result *secretsmanagerv2.SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+	GetSecretFunc                        func(ctx context.Context, getSecretOptions *secretsmanagerv2.GetSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error)
+	DeleteSecretFunc                     func(ctx context.Context, deleteSecretOptions *secretsmanagerv2.DeleteSecretOptions) (response *core.DetailedResponse, err error)
+	GetSecretMetadataFunc                func(ctx context.Context, getSecretMetadataOptions *secretsmanagerv2.GetSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error)
+	UpdateSecretMetadataFunc             func(ctx context.Context, updateSecretMetadataOptions *secretsmanagerv2.UpdateSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error)
+	CreateSecretActionFunc               func(ctx context.Context, createSecretActionOptions *secretsmanagerv2.CreateSecretActionOptions) (result secretsmanagerv2.SecretActionIntf, response *core.DetailedResponse, err error)
+	GetSecretByNameTypeFunc              func(ctx context.Context, getSecretByNameTypeOptions *secretsmanagerv2.GetSecretByNameTypeOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error)
+	CreateSecretVersionFunc              func(ctx context.Context, createSecretVersionOptions *secretsmanagerv2.CreateSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error)
+	ListSecretVersionsFunc               func(ctx context.Context, listSecretVersionsOptions *secretsmanagerv2.ListSecretVersionsOptions) (result *secretsmanagerv2.SecretVersionMetadataCollection, response *core.DetailedResponse, err error)
+	GetSecretVersionFunc                 func(ctx context.Context, getSecretVersionOptions *secretsmanagerv2.GetSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error)
+	DeleteSecretVersionDataFunc          func(ctx context.Context, deleteSecretVersionDataOptions *secretsmanagerv2.DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error)
+	GetSecretVersionMetadataFunc         func(ctx context.Context, getSecretVersionMetadataOptions *secretsmanagerv2.GetSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+	UpdateSecretVersionMetadataFunc      func(ctx context.Context, updateSecretVersionMetadataOptions *secretsmanagerv2.UpdateSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error)
+	CreateSecretVersionActionFunc        func(ctx context.Context, createSecretVersionActionOptions *secretsmanagerv2.CreateSecretVersionActionOptions) (result secretsmanagerv2.VersionActionIntf, response *core.DetailedResponse, err error)
+	ListSecretTasksFunc                  func(ctx context.Context, listSecretTasksOptions *secretsmanagerv2.ListSecretTasksOptions) (result *secretsmanagerv2.SecretTaskCollection, response *core.DetailedResponse, err error)
+	GetSecretTaskFunc                    func(ctx context.Context, getSecretTaskOptions *secretsmanagerv2.GetSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error)
+	ReplaceSecretTaskFunc                func(ctx context.Context, replaceSecretTaskOptions *secretsmanagerv2.ReplaceSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error)
+	DeleteSecretTaskFunc                 func(ctx context.Context, deleteSecretTaskOptions *secretsmanagerv2.DeleteSecretTaskOptions) (response *core.DetailedResponse, err error)
+	ListSecretsLocksFunc                 func(ctx context.Context, listSecretsLocksOptions *secretsmanagerv2.ListSecretsLocksOptions) (result *secretsmanagerv2.SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	ListSecretLocksFunc                  func(ctx context.Context, listSecretLocksOptions *secretsmanagerv2.ListSecretLocksOptions) (result *secretsmanagerv2.SecretLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	CreateSecretLocksBulkFunc            func(ctx context.Context, createSecretLocksBulkOptions *secretsmanagerv2.CreateSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error)
+	DeleteSecretLocksBulkFunc            func(ctx context.Context, deleteSecretLocksBulkOptions *secretsmanagerv2.DeleteSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error)
+	ListSecretVersionLocksFunc           func(ctx context.Context, listSecretVersionLocksOptions *secretsmanagerv2.ListSecretVersionLocksOptions) (result *secretsmanagerv2.SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error)
+	CreateSecretVersionLocksBulkFunc     func(ctx context.Context, createSecretVersionLocksBulkOptions *secretsmanagerv2.CreateSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error)
+	DeleteSecretVersionLocksBulkFunc     func(ctx context.Context, deleteSecretVersionLocksBulkOptions *secretsmanagerv2.DeleteSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error)
+	CreateConfigurationFunc              func(ctx context.Context, createConfigurationOptions *secretsmanagerv2.CreateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error)
+	ListConfigurationsFunc               func(ctx context.Context, listConfigurationsOptions *secretsmanagerv2.ListConfigurationsOptions) (result *secretsmanagerv2.ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error)
+	GetConfigurationFunc                 func(ctx context.Context, getConfigurationOptions *secretsmanagerv2.GetConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error)
+	UpdateConfigurationFunc              func(ctx context.Context, updateConfigurationOptions *secretsmanagerv2.UpdateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error)
+	DeleteConfigurationFunc              func(ctx context.Context, deleteConfigurationOptions *secretsmanagerv2.DeleteConfigurationOptions) (response *core.DetailedResponse, err error)
+	CreateConfigurationActionFunc        func(ctx context.Context, createConfigurationActionOptions *secretsmanagerv2.CreateConfigurationActionOptions) (result secretsmanagerv2.ConfigurationActionIntf, response *core.DetailedResponse, err error)
+	CreateNotificationsRegistrationFunc  func(ctx context.Context, createNotificationsRegistrationOptions *secretsmanagerv2.CreateNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error)
+	GetNotificationsRegistrationFunc     func(ctx context.Context, getNotificationsRegistrationOptions *secretsmanagerv2.GetNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error)
+	DeleteNotificationsRegistrationFunc  func(ctx context.Context, deleteNotificationsRegistrationOptions *secretsmanagerv2.DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error)
+	GetNotificationsRegistrationTestFunc func(ctx context.Context, getNotificationsRegistrationTestOptions *secretsmanagerv2.GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error)
+
+	calls mockCalls
+}
+
+var _ secretsmanagerv2.SecretsManagerV2Intf = (*MockSecretsManagerV2)(nil)
+
+// CreateSecretGroup calls CreateSecretGroupFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretGroup(createSecretGroupOptions *secretsmanagerv2.CreateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretGroupWithContext(context.Background(), createSecretGroupOptions)
+}
+
+// CreateSecretGroupWithContext calls CreateSecretGroupFunc.
+func (mock *MockSecretsManagerV2) CreateSecretGroupWithContext(ctx context.Context, createSecretGroupOptions *secretsmanagerv2.CreateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretGroup", createSecretGroupOptions)
+	if mock.CreateSecretGroupFunc == nil {
+		err = errNotMocked("CreateSecretGroup")
+		return
+	}
+	return mock.CreateSecretGroupFunc(ctx, createSecretGroupOptions)
+}
+
+// ListSecretGroups calls ListSecretGroupsFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretGroups(listSecretGroupsOptions *secretsmanagerv2.ListSecretGroupsOptions) (result *secretsmanagerv2.SecretGroupCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretGroupsWithContext(context.Background(), listSecretGroupsOptions)
+}
+
+// ListSecretGroupsWithContext calls ListSecretGroupsFunc.
+func (mock *MockSecretsManagerV2) ListSecretGroupsWithContext(ctx context.Context, listSecretGroupsOptions *secretsmanagerv2.ListSecretGroupsOptions) (result *secretsmanagerv2.SecretGroupCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretGroups", listSecretGroupsOptions)
+	if mock.ListSecretGroupsFunc == nil {
+		err = errNotMocked("ListSecretGroups")
+		return
+	}
+	return mock.ListSecretGroupsFunc(ctx, listSecretGroupsOptions)
+}
+
+// GetSecretGroup calls GetSecretGroupFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretGroup(getSecretGroupOptions *secretsmanagerv2.GetSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	return mock.GetSecretGroupWithContext(context.Background(), getSecretGroupOptions)
+}
+
+// GetSecretGroupWithContext calls GetSecretGroupFunc.
+func (mock *MockSecretsManagerV2) GetSecretGroupWithContext(ctx context.Context, getSecretGroupOptions *secretsmanagerv2.GetSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretGroup", getSecretGroupOptions)
+	if mock.GetSecretGroupFunc == nil {
+		err = errNotMocked("GetSecretGroup")
+		return
+	}
+	return mock.GetSecretGroupFunc(ctx, getSecretGroupOptions)
+}
+
+// UpdateSecretGroup calls UpdateSecretGroupFunc with context.Background().
+func (mock *MockSecretsManagerV2) UpdateSecretGroup(updateSecretGroupOptions *secretsmanagerv2.UpdateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	return mock.UpdateSecretGroupWithContext(context.Background(), updateSecretGroupOptions)
+}
+
+// UpdateSecretGroupWithContext calls UpdateSecretGroupFunc.
+func (mock *MockSecretsManagerV2) UpdateSecretGroupWithContext(ctx context.Context, updateSecretGroupOptions *secretsmanagerv2.UpdateSecretGroupOptions) (result *secretsmanagerv2.SecretGroup, response *core.DetailedResponse, err error) {
+	mock.calls.record("UpdateSecretGroup", updateSecretGroupOptions)
+	if mock.UpdateSecretGroupFunc == nil {
+		err = errNotMocked("UpdateSecretGroup")
+		return
+	}
+	return mock.UpdateSecretGroupFunc(ctx, updateSecretGroupOptions)
+}
+
+// DeleteSecretGroup calls DeleteSecretGroupFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecretGroup(deleteSecretGroupOptions *secretsmanagerv2.DeleteSecretGroupOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretGroupWithContext(context.Background(), deleteSecretGroupOptions)
+}
+
+// DeleteSecretGroupWithContext calls DeleteSecretGroupFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretGroupWithContext(ctx context.Context, deleteSecretGroupOptions *secretsmanagerv2.DeleteSecretGroupOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecretGroup", deleteSecretGroupOptions)
+	if mock.DeleteSecretGroupFunc == nil {
+		err = errNotMocked("DeleteSecretGroup")
+		return
+	}
+	return mock.DeleteSecretGroupFunc(ctx, deleteSecretGroupOptions)
+}
+
+// CreateSecret calls CreateSecretFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecret(createSecretOptions *secretsmanagerv2.CreateSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretWithContext(context.Background(), createSecretOptions)
+}
+
+// CreateSecretWithContext calls CreateSecretFunc.
+func (mock *MockSecretsManagerV2) CreateSecretWithContext(ctx context.Context, createSecretOptions *secretsmanagerv2.CreateSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecret", createSecretOptions)
+	if mock.CreateSecretFunc == nil {
+		err = errNotMocked("CreateSecret")
+		return
+	}
+	return mock.CreateSecretFunc(ctx, createSecretOptions)
+}
+
+// ListSecrets calls ListSecretsFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecrets(listSecretsOptions *secretsmanagerv2.ListSecretsOptions) (result *secretsmanagerv2.SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretsWithContext(context.Background(), listSecretsOptions)
+}
+
+// ListSecretsWithContext calls ListSecretsFunc.
+func (mock *MockSecretsManagerV2) ListSecretsWithContext(ctx context.Context, listSecretsOptions *secretsmanagerv2.ListSecretsOptions) (result *secretsmanagerv2.SecretMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecrets", listSecretsOptions)
+	if mock.ListSecretsFunc == nil {
+		err = errNotMocked("ListSecrets")
+		return
+	}
+	return mock.ListSecretsFunc(ctx, listSecretsOptions)
+}
+
+// GetSecret calls GetSecretFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecret(getSecretOptions *secretsmanagerv2.GetSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	return mock.GetSecretWithContext(context.Background(), getSecretOptions)
+}
+
+// GetSecretWithContext calls GetSecretFunc.
+func (mock *MockSecretsManagerV2) GetSecretWithContext(ctx context.Context, getSecretOptions *secretsmanagerv2.GetSecretOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecret", getSecretOptions)
+	if mock.GetSecretFunc == nil {
+		err = errNotMocked("GetSecret")
+		return
+	}
+	return mock.GetSecretFunc(ctx, getSecretOptions)
+}
+
+// DeleteSecret calls DeleteSecretFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecret(deleteSecretOptions *secretsmanagerv2.DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretWithContext(context.Background(), deleteSecretOptions)
+}
+
+// DeleteSecretWithContext calls DeleteSecretFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretWithContext(ctx context.Context, deleteSecretOptions *secretsmanagerv2.DeleteSecretOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecret", deleteSecretOptions)
+	if mock.DeleteSecretFunc == nil {
+		err = errNotMocked("DeleteSecret")
+		return
+	}
+	return mock.DeleteSecretFunc(ctx, deleteSecretOptions)
+}
+
+// GetSecretMetadata calls GetSecretMetadataFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretMetadata(getSecretMetadataOptions *secretsmanagerv2.GetSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	return mock.GetSecretMetadataWithContext(context.Background(), getSecretMetadataOptions)
+}
+
+// GetSecretMetadataWithContext calls GetSecretMetadataFunc.
+func (mock *MockSecretsManagerV2) GetSecretMetadataWithContext(ctx context.Context, getSecretMetadataOptions *secretsmanagerv2.GetSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretMetadata", getSecretMetadataOptions)
+	if mock.GetSecretMetadataFunc == nil {
+		err = errNotMocked("GetSecretMetadata")
+		return
+	}
+	return mock.GetSecretMetadataFunc(ctx, getSecretMetadataOptions)
+}
+
+// UpdateSecretMetadata calls UpdateSecretMetadataFunc with context.Background().
+func (mock *MockSecretsManagerV2) UpdateSecretMetadata(updateSecretMetadataOptions *secretsmanagerv2.UpdateSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	return mock.UpdateSecretMetadataWithContext(context.Background(), updateSecretMetadataOptions)
+}
+
+// UpdateSecretMetadataWithContext calls UpdateSecretMetadataFunc.
+func (mock *MockSecretsManagerV2) UpdateSecretMetadataWithContext(ctx context.Context, updateSecretMetadataOptions *secretsmanagerv2.UpdateSecretMetadataOptions) (result secretsmanagerv2.SecretMetadataIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("UpdateSecretMetadata", updateSecretMetadataOptions)
+	if mock.UpdateSecretMetadataFunc == nil {
+		err = errNotMocked("UpdateSecretMetadata")
+		return
+	}
+	return mock.UpdateSecretMetadataFunc(ctx, updateSecretMetadataOptions)
+}
+
+// CreateSecretAction calls CreateSecretActionFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretAction(createSecretActionOptions *secretsmanagerv2.CreateSecretActionOptions) (result secretsmanagerv2.SecretActionIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretActionWithContext(context.Background(), createSecretActionOptions)
+}
+
+// CreateSecretActionWithContext calls CreateSecretActionFunc.
+func (mock *MockSecretsManagerV2) CreateSecretActionWithContext(ctx context.Context, createSecretActionOptions *secretsmanagerv2.CreateSecretActionOptions) (result secretsmanagerv2.SecretActionIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretAction", createSecretActionOptions)
+	if mock.CreateSecretActionFunc == nil {
+		err = errNotMocked("CreateSecretAction")
+		return
+	}
+	return mock.CreateSecretActionFunc(ctx, createSecretActionOptions)
+}
+
+// GetSecretByNameType calls GetSecretByNameTypeFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretByNameType(getSecretByNameTypeOptions *secretsmanagerv2.GetSecretByNameTypeOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	return mock.GetSecretByNameTypeWithContext(context.Background(), getSecretByNameTypeOptions)
+}
+
+// GetSecretByNameTypeWithContext calls GetSecretByNameTypeFunc.
+func (mock *MockSecretsManagerV2) GetSecretByNameTypeWithContext(ctx context.Context, getSecretByNameTypeOptions *secretsmanagerv2.GetSecretByNameTypeOptions) (result secretsmanagerv2.SecretIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretByNameType", getSecretByNameTypeOptions)
+	if mock.GetSecretByNameTypeFunc == nil {
+		err = errNotMocked("GetSecretByNameType")
+		return
+	}
+	return mock.GetSecretByNameTypeFunc(ctx, getSecretByNameTypeOptions)
+}
+
+// CreateSecretVersion calls CreateSecretVersionFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretVersion(createSecretVersionOptions *secretsmanagerv2.CreateSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretVersionWithContext(context.Background(), createSecretVersionOptions)
+}
+
+// CreateSecretVersionWithContext calls CreateSecretVersionFunc.
+func (mock *MockSecretsManagerV2) CreateSecretVersionWithContext(ctx context.Context, createSecretVersionOptions *secretsmanagerv2.CreateSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretVersion", createSecretVersionOptions)
+	if mock.CreateSecretVersionFunc == nil {
+		err = errNotMocked("CreateSecretVersion")
+		return
+	}
+	return mock.CreateSecretVersionFunc(ctx, createSecretVersionOptions)
+}
+
+// ListSecretVersions calls ListSecretVersionsFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretVersions(listSecretVersionsOptions *secretsmanagerv2.ListSecretVersionsOptions) (result *secretsmanagerv2.SecretVersionMetadataCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretVersionsWithContext(context.Background(), listSecretVersionsOptions)
+}
+
+// ListSecretVersionsWithContext calls ListSecretVersionsFunc.
+func (mock *MockSecretsManagerV2) ListSecretVersionsWithContext(ctx context.Context, listSecretVersionsOptions *secretsmanagerv2.ListSecretVersionsOptions) (result *secretsmanagerv2.SecretVersionMetadataCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretVersions", listSecretVersionsOptions)
+	if mock.ListSecretVersionsFunc == nil {
+		err = errNotMocked("ListSecretVersions")
+		return
+	}
+	return mock.ListSecretVersionsFunc(ctx, listSecretVersionsOptions)
+}
+
+// GetSecretVersion calls GetSecretVersionFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretVersion(getSecretVersionOptions *secretsmanagerv2.GetSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error) {
+	return mock.GetSecretVersionWithContext(context.Background(), getSecretVersionOptions)
+}
+
+// GetSecretVersionWithContext calls GetSecretVersionFunc.
+func (mock *MockSecretsManagerV2) GetSecretVersionWithContext(ctx context.Context, getSecretVersionOptions *secretsmanagerv2.GetSecretVersionOptions) (result secretsmanagerv2.SecretVersionIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretVersion", getSecretVersionOptions)
+	if mock.GetSecretVersionFunc == nil {
+		err = errNotMocked("GetSecretVersion")
+		return
+	}
+	return mock.GetSecretVersionFunc(ctx, getSecretVersionOptions)
+}
+
+// DeleteSecretVersionData calls DeleteSecretVersionDataFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecretVersionData(deleteSecretVersionDataOptions *secretsmanagerv2.DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretVersionDataWithContext(context.Background(), deleteSecretVersionDataOptions)
+}
+
+// DeleteSecretVersionDataWithContext calls DeleteSecretVersionDataFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretVersionDataWithContext(ctx context.Context, deleteSecretVersionDataOptions *secretsmanagerv2.DeleteSecretVersionDataOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecretVersionData", deleteSecretVersionDataOptions)
+	if mock.DeleteSecretVersionDataFunc == nil {
+		err = errNotMocked("DeleteSecretVersionData")
+		return
+	}
+	return mock.DeleteSecretVersionDataFunc(ctx, deleteSecretVersionDataOptions)
+}
+
+// GetSecretVersionMetadata calls GetSecretVersionMetadataFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretVersionMetadata(getSecretVersionMetadataOptions *secretsmanagerv2.GetSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	return mock.GetSecretVersionMetadataWithContext(context.Background(), getSecretVersionMetadataOptions)
+}
+
+// GetSecretVersionMetadataWithContext calls GetSecretVersionMetadataFunc.
+func (mock *MockSecretsManagerV2) GetSecretVersionMetadataWithContext(ctx context.Context, getSecretVersionMetadataOptions *secretsmanagerv2.GetSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretVersionMetadata", getSecretVersionMetadataOptions)
+	if mock.GetSecretVersionMetadataFunc == nil {
+		err = errNotMocked("GetSecretVersionMetadata")
+		return
+	}
+	return mock.GetSecretVersionMetadataFunc(ctx, getSecretVersionMetadataOptions)
+}
+
+// UpdateSecretVersionMetadata calls UpdateSecretVersionMetadataFunc with context.Background().
+func (mock *MockSecretsManagerV2) UpdateSecretVersionMetadata(updateSecretVersionMetadataOptions *secretsmanagerv2.UpdateSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	return mock.UpdateSecretVersionMetadataWithContext(context.Background(), updateSecretVersionMetadataOptions)
+}
+
+// UpdateSecretVersionMetadataWithContext calls UpdateSecretVersionMetadataFunc.
+func (mock *MockSecretsManagerV2) UpdateSecretVersionMetadataWithContext(ctx context.Context, updateSecretVersionMetadataOptions *secretsmanagerv2.UpdateSecretVersionMetadataOptions) (result secretsmanagerv2.SecretVersionMetadataIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("UpdateSecretVersionMetadata", updateSecretVersionMetadataOptions)
+	if mock.UpdateSecretVersionMetadataFunc == nil {
+		err = errNotMocked("UpdateSecretVersionMetadata")
+		return
+	}
+	return mock.UpdateSecretVersionMetadataFunc(ctx, updateSecretVersionMetadataOptions)
+}
+
+// CreateSecretVersionAction calls CreateSecretVersionActionFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretVersionAction(createSecretVersionActionOptions *secretsmanagerv2.CreateSecretVersionActionOptions) (result secretsmanagerv2.VersionActionIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretVersionActionWithContext(context.Background(), createSecretVersionActionOptions)
+}
+
+// CreateSecretVersionActionWithContext calls CreateSecretVersionActionFunc.
+func (mock *MockSecretsManagerV2) CreateSecretVersionActionWithContext(ctx context.Context, createSecretVersionActionOptions *secretsmanagerv2.CreateSecretVersionActionOptions) (result secretsmanagerv2.VersionActionIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretVersionAction", createSecretVersionActionOptions)
+	if mock.CreateSecretVersionActionFunc == nil {
+		err = errNotMocked("CreateSecretVersionAction")
+		return
+	}
+	return mock.CreateSecretVersionActionFunc(ctx, createSecretVersionActionOptions)
+}
+
+// ListSecretTasks calls ListSecretTasksFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretTasks(listSecretTasksOptions *secretsmanagerv2.ListSecretTasksOptions) (result *secretsmanagerv2.SecretTaskCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretTasksWithContext(context.Background(), listSecretTasksOptions)
+}
+
+// ListSecretTasksWithContext calls ListSecretTasksFunc.
+func (mock *MockSecretsManagerV2) ListSecretTasksWithContext(ctx context.Context, listSecretTasksOptions *secretsmanagerv2.ListSecretTasksOptions) (result *secretsmanagerv2.SecretTaskCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretTasks", listSecretTasksOptions)
+	if mock.ListSecretTasksFunc == nil {
+		err = errNotMocked("ListSecretTasks")
+		return
+	}
+	return mock.ListSecretTasksFunc(ctx, listSecretTasksOptions)
+}
+
+// GetSecretTask calls GetSecretTaskFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetSecretTask(getSecretTaskOptions *secretsmanagerv2.GetSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error) {
+	return mock.GetSecretTaskWithContext(context.Background(), getSecretTaskOptions)
+}
+
+// GetSecretTaskWithContext calls GetSecretTaskFunc.
+func (mock *MockSecretsManagerV2) GetSecretTaskWithContext(ctx context.Context, getSecretTaskOptions *secretsmanagerv2.GetSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetSecretTask", getSecretTaskOptions)
+	if mock.GetSecretTaskFunc == nil {
+		err = errNotMocked("GetSecretTask")
+		return
+	}
+	return mock.GetSecretTaskFunc(ctx, getSecretTaskOptions)
+}
+
+// ReplaceSecretTask calls ReplaceSecretTaskFunc with context.Background().
+func (mock *MockSecretsManagerV2) ReplaceSecretTask(replaceSecretTaskOptions *secretsmanagerv2.ReplaceSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error) {
+	return mock.ReplaceSecretTaskWithContext(context.Background(), replaceSecretTaskOptions)
+}
+
+// ReplaceSecretTaskWithContext calls ReplaceSecretTaskFunc.
+func (mock *MockSecretsManagerV2) ReplaceSecretTaskWithContext(ctx context.Context, replaceSecretTaskOptions *secretsmanagerv2.ReplaceSecretTaskOptions) (result *secretsmanagerv2.SecretTask, response *core.DetailedResponse, err error) {
+	mock.calls.record("ReplaceSecretTask", replaceSecretTaskOptions)
+	if mock.ReplaceSecretTaskFunc == nil {
+		err = errNotMocked("ReplaceSecretTask")
+		return
+	}
+	return mock.ReplaceSecretTaskFunc(ctx, replaceSecretTaskOptions)
+}
+
+// DeleteSecretTask calls DeleteSecretTaskFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecretTask(deleteSecretTaskOptions *secretsmanagerv2.DeleteSecretTaskOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretTaskWithContext(context.Background(), deleteSecretTaskOptions)
+}
+
+// DeleteSecretTaskWithContext calls DeleteSecretTaskFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretTaskWithContext(ctx context.Context, deleteSecretTaskOptions *secretsmanagerv2.DeleteSecretTaskOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecretTask", deleteSecretTaskOptions)
+	if mock.DeleteSecretTaskFunc == nil {
+		err = errNotMocked("DeleteSecretTask")
+		return
+	}
+	return mock.DeleteSecretTaskFunc(ctx, deleteSecretTaskOptions)
+}
+
+// ListSecretsLocks calls ListSecretsLocksFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretsLocks(listSecretsLocksOptions *secretsmanagerv2.ListSecretsLocksOptions) (result *secretsmanagerv2.SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretsLocksWithContext(context.Background(), listSecretsLocksOptions)
+}
+
+// ListSecretsLocksWithContext calls ListSecretsLocksFunc.
+func (mock *MockSecretsManagerV2) ListSecretsLocksWithContext(ctx context.Context, listSecretsLocksOptions *secretsmanagerv2.ListSecretsLocksOptions) (result *secretsmanagerv2.SecretsLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretsLocks", listSecretsLocksOptions)
+	if mock.ListSecretsLocksFunc == nil {
+		err = errNotMocked("ListSecretsLocks")
+		return
+	}
+	return mock.ListSecretsLocksFunc(ctx, listSecretsLocksOptions)
+}
+
+// ListSecretLocks calls ListSecretLocksFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretLocks(listSecretLocksOptions *secretsmanagerv2.ListSecretLocksOptions) (result *secretsmanagerv2.SecretLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretLocksWithContext(context.Background(), listSecretLocksOptions)
+}
+
+// ListSecretLocksWithContext calls ListSecretLocksFunc.
+func (mock *MockSecretsManagerV2) ListSecretLocksWithContext(ctx context.Context, listSecretLocksOptions *secretsmanagerv2.ListSecretLocksOptions) (result *secretsmanagerv2.SecretLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretLocks", listSecretLocksOptions)
+	if mock.ListSecretLocksFunc == nil {
+		err = errNotMocked("ListSecretLocks")
+		return
+	}
+	return mock.ListSecretLocksFunc(ctx, listSecretLocksOptions)
+}
+
+// CreateSecretLocksBulk calls CreateSecretLocksBulkFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretLocksBulk(createSecretLocksBulkOptions *secretsmanagerv2.CreateSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretLocksBulkWithContext(context.Background(), createSecretLocksBulkOptions)
+}
+
+// CreateSecretLocksBulkWithContext calls CreateSecretLocksBulkFunc.
+func (mock *MockSecretsManagerV2) CreateSecretLocksBulkWithContext(ctx context.Context, createSecretLocksBulkOptions *secretsmanagerv2.CreateSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretLocksBulk", createSecretLocksBulkOptions)
+	if mock.CreateSecretLocksBulkFunc == nil {
+		err = errNotMocked("CreateSecretLocksBulk")
+		return
+	}
+	return mock.CreateSecretLocksBulkFunc(ctx, createSecretLocksBulkOptions)
+}
+
+// DeleteSecretLocksBulk calls DeleteSecretLocksBulkFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecretLocksBulk(deleteSecretLocksBulkOptions *secretsmanagerv2.DeleteSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretLocksBulkWithContext(context.Background(), deleteSecretLocksBulkOptions)
+}
+
+// DeleteSecretLocksBulkWithContext calls DeleteSecretLocksBulkFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretLocksBulkWithContext(ctx context.Context, deleteSecretLocksBulkOptions *secretsmanagerv2.DeleteSecretLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecretLocksBulk", deleteSecretLocksBulkOptions)
+	if mock.DeleteSecretLocksBulkFunc == nil {
+		err = errNotMocked("DeleteSecretLocksBulk")
+		return
+	}
+	return mock.DeleteSecretLocksBulkFunc(ctx, deleteSecretLocksBulkOptions)
+}
+
+// ListSecretVersionLocks calls ListSecretVersionLocksFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListSecretVersionLocks(listSecretVersionLocksOptions *secretsmanagerv2.ListSecretVersionLocksOptions) (result *secretsmanagerv2.SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	return mock.ListSecretVersionLocksWithContext(context.Background(), listSecretVersionLocksOptions)
+}
+
+// ListSecretVersionLocksWithContext calls ListSecretVersionLocksFunc.
+func (mock *MockSecretsManagerV2) ListSecretVersionLocksWithContext(ctx context.Context, listSecretVersionLocksOptions *secretsmanagerv2.ListSecretVersionLocksOptions) (result *secretsmanagerv2.SecretVersionLocksPaginatedCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListSecretVersionLocks", listSecretVersionLocksOptions)
+	if mock.ListSecretVersionLocksFunc == nil {
+		err = errNotMocked("ListSecretVersionLocks")
+		return
+	}
+	return mock.ListSecretVersionLocksFunc(ctx, listSecretVersionLocksOptions)
+}
+
+// CreateSecretVersionLocksBulk calls CreateSecretVersionLocksBulkFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateSecretVersionLocksBulk(createSecretVersionLocksBulkOptions *secretsmanagerv2.CreateSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	return mock.CreateSecretVersionLocksBulkWithContext(context.Background(), createSecretVersionLocksBulkOptions)
+}
+
+// CreateSecretVersionLocksBulkWithContext calls CreateSecretVersionLocksBulkFunc.
+func (mock *MockSecretsManagerV2) CreateSecretVersionLocksBulkWithContext(ctx context.Context, createSecretVersionLocksBulkOptions *secretsmanagerv2.CreateSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateSecretVersionLocksBulk", createSecretVersionLocksBulkOptions)
+	if mock.CreateSecretVersionLocksBulkFunc == nil {
+		err = errNotMocked("CreateSecretVersionLocksBulk")
+		return
+	}
+	return mock.CreateSecretVersionLocksBulkFunc(ctx, createSecretVersionLocksBulkOptions)
+}
+
+// DeleteSecretVersionLocksBulk calls DeleteSecretVersionLocksBulkFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteSecretVersionLocksBulk(deleteSecretVersionLocksBulkOptions *secretsmanagerv2.DeleteSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	return mock.DeleteSecretVersionLocksBulkWithContext(context.Background(), deleteSecretVersionLocksBulkOptions)
+}
+
+// DeleteSecretVersionLocksBulkWithContext calls DeleteSecretVersionLocksBulkFunc.
+func (mock *MockSecretsManagerV2) DeleteSecretVersionLocksBulkWithContext(ctx context.Context, deleteSecretVersionLocksBulkOptions *secretsmanagerv2.DeleteSecretVersionLocksBulkOptions) (result *secretsmanagerv2.SecretLocks, response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteSecretVersionLocksBulk", deleteSecretVersionLocksBulkOptions)
+	if mock.DeleteSecretVersionLocksBulkFunc == nil {
+		err = errNotMocked("DeleteSecretVersionLocksBulk")
+		return
+	}
+	return mock.DeleteSecretVersionLocksBulkFunc(ctx, deleteSecretVersionLocksBulkOptions)
+}
+
+// CreateConfiguration calls CreateConfigurationFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateConfiguration(createConfigurationOptions *secretsmanagerv2.CreateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateConfigurationWithContext(context.Background(), createConfigurationOptions)
+}
+
+// CreateConfigurationWithContext calls CreateConfigurationFunc.
+func (mock *MockSecretsManagerV2) CreateConfigurationWithContext(ctx context.Context, createConfigurationOptions *secretsmanagerv2.CreateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateConfiguration", createConfigurationOptions)
+	if mock.CreateConfigurationFunc == nil {
+		err = errNotMocked("CreateConfiguration")
+		return
+	}
+	return mock.CreateConfigurationFunc(ctx, createConfigurationOptions)
+}
+
+// ListConfigurations calls ListConfigurationsFunc with context.Background().
+func (mock *MockSecretsManagerV2) ListConfigurations(listConfigurationsOptions *secretsmanagerv2.ListConfigurationsOptions) (result *secretsmanagerv2.ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	return mock.ListConfigurationsWithContext(context.Background(), listConfigurationsOptions)
+}
+
+// ListConfigurationsWithContext calls ListConfigurationsFunc.
+func (mock *MockSecretsManagerV2) ListConfigurationsWithContext(ctx context.Context, listConfigurationsOptions *secretsmanagerv2.ListConfigurationsOptions) (result *secretsmanagerv2.ConfigurationMetadataPaginatedCollection, response *core.DetailedResponse, err error) {
+	mock.calls.record("ListConfigurations", listConfigurationsOptions)
+	if mock.ListConfigurationsFunc == nil {
+		err = errNotMocked("ListConfigurations")
+		return
+	}
+	return mock.ListConfigurationsFunc(ctx, listConfigurationsOptions)
+}
+
+// GetConfiguration calls GetConfigurationFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetConfiguration(getConfigurationOptions *secretsmanagerv2.GetConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	return mock.GetConfigurationWithContext(context.Background(), getConfigurationOptions)
+}
+
+// GetConfigurationWithContext calls GetConfigurationFunc.
+func (mock *MockSecretsManagerV2) GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *secretsmanagerv2.GetConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetConfiguration", getConfigurationOptions)
+	if mock.GetConfigurationFunc == nil {
+		err = errNotMocked("GetConfiguration")
+		return
+	}
+	return mock.GetConfigurationFunc(ctx, getConfigurationOptions)
+}
+
+// UpdateConfiguration calls UpdateConfigurationFunc with context.Background().
+func (mock *MockSecretsManagerV2) UpdateConfiguration(updateConfigurationOptions *secretsmanagerv2.UpdateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	return mock.UpdateConfigurationWithContext(context.Background(), updateConfigurationOptions)
+}
+
+// UpdateConfigurationWithContext calls UpdateConfigurationFunc.
+func (mock *MockSecretsManagerV2) UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *secretsmanagerv2.UpdateConfigurationOptions) (result secretsmanagerv2.ConfigurationIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("UpdateConfiguration", updateConfigurationOptions)
+	if mock.UpdateConfigurationFunc == nil {
+		err = errNotMocked("UpdateConfiguration")
+		return
+	}
+	return mock.UpdateConfigurationFunc(ctx, updateConfigurationOptions)
+}
+
+// DeleteConfiguration calls DeleteConfigurationFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteConfiguration(deleteConfigurationOptions *secretsmanagerv2.DeleteConfigurationOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteConfigurationWithContext(context.Background(), deleteConfigurationOptions)
+}
+
+// DeleteConfigurationWithContext calls DeleteConfigurationFunc.
+func (mock *MockSecretsManagerV2) DeleteConfigurationWithContext(ctx context.Context, deleteConfigurationOptions *secretsmanagerv2.DeleteConfigurationOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteConfiguration", deleteConfigurationOptions)
+	if mock.DeleteConfigurationFunc == nil {
+		err = errNotMocked("DeleteConfiguration")
+		return
+	}
+	return mock.DeleteConfigurationFunc(ctx, deleteConfigurationOptions)
+}
+
+// CreateConfigurationAction calls CreateConfigurationActionFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateConfigurationAction(createConfigurationActionOptions *secretsmanagerv2.CreateConfigurationActionOptions) (result secretsmanagerv2.ConfigurationActionIntf, response *core.DetailedResponse, err error) {
+	return mock.CreateConfigurationActionWithContext(context.Background(), createConfigurationActionOptions)
+}
+
+// CreateConfigurationActionWithContext calls CreateConfigurationActionFunc.
+func (mock *MockSecretsManagerV2) CreateConfigurationActionWithContext(ctx context.Context, createConfigurationActionOptions *secretsmanagerv2.CreateConfigurationActionOptions) (result secretsmanagerv2.ConfigurationActionIntf, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateConfigurationAction", createConfigurationActionOptions)
+	if mock.CreateConfigurationActionFunc == nil {
+		err = errNotMocked("CreateConfigurationAction")
+		return
+	}
+	return mock.CreateConfigurationActionFunc(ctx, createConfigurationActionOptions)
+}
+
+// CreateNotificationsRegistration calls CreateNotificationsRegistrationFunc with context.Background().
+func (mock *MockSecretsManagerV2) CreateNotificationsRegistration(createNotificationsRegistrationOptions *secretsmanagerv2.CreateNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error) {
+	return mock.CreateNotificationsRegistrationWithContext(context.Background(), createNotificationsRegistrationOptions)
+}
+
+// CreateNotificationsRegistrationWithContext calls CreateNotificationsRegistrationFunc.
+func (mock *MockSecretsManagerV2) CreateNotificationsRegistrationWithContext(ctx context.Context, createNotificationsRegistrationOptions *secretsmanagerv2.CreateNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error) {
+	mock.calls.record("CreateNotificationsRegistration", createNotificationsRegistrationOptions)
+	if mock.CreateNotificationsRegistrationFunc == nil {
+		err = errNotMocked("CreateNotificationsRegistration")
+		return
+	}
+	return mock.CreateNotificationsRegistrationFunc(ctx, createNotificationsRegistrationOptions)
+}
+
+// GetNotificationsRegistration calls GetNotificationsRegistrationFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetNotificationsRegistration(getNotificationsRegistrationOptions *secretsmanagerv2.GetNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error) {
+	return mock.GetNotificationsRegistrationWithContext(context.Background(), getNotificationsRegistrationOptions)
+}
+
+// GetNotificationsRegistrationWithContext calls GetNotificationsRegistrationFunc.
+func (mock *MockSecretsManagerV2) GetNotificationsRegistrationWithContext(ctx context.Context, getNotificationsRegistrationOptions *secretsmanagerv2.GetNotificationsRegistrationOptions) (result *secretsmanagerv2.NotificationsRegistration, response *core.DetailedResponse, err error) {
+	mock.calls.record("GetNotificationsRegistration", getNotificationsRegistrationOptions)
+	if mock.GetNotificationsRegistrationFunc == nil {
+		err = errNotMocked("GetNotificationsRegistration")
+		return
+	}
+	return mock.GetNotificationsRegistrationFunc(ctx, getNotificationsRegistrationOptions)
+}
+
+// DeleteNotificationsRegistration calls DeleteNotificationsRegistrationFunc with context.Background().
+func (mock *MockSecretsManagerV2) DeleteNotificationsRegistration(deleteNotificationsRegistrationOptions *secretsmanagerv2.DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error) {
+	return mock.DeleteNotificationsRegistrationWithContext(context.Background(), deleteNotificationsRegistrationOptions)
+}
+
+// DeleteNotificationsRegistrationWithContext calls DeleteNotificationsRegistrationFunc.
+func (mock *MockSecretsManagerV2) DeleteNotificationsRegistrationWithContext(ctx context.Context, deleteNotificationsRegistrationOptions *secretsmanagerv2.DeleteNotificationsRegistrationOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("DeleteNotificationsRegistration", deleteNotificationsRegistrationOptions)
+	if mock.DeleteNotificationsRegistrationFunc == nil {
+		err = errNotMocked("DeleteNotificationsRegistration")
+		return
+	}
+	return mock.DeleteNotificationsRegistrationFunc(ctx, deleteNotificationsRegistrationOptions)
+}
+
+// GetNotificationsRegistrationTest calls GetNotificationsRegistrationTestFunc with context.Background().
+func (mock *MockSecretsManagerV2) GetNotificationsRegistrationTest(getNotificationsRegistrationTestOptions *secretsmanagerv2.GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error) {
+	return mock.GetNotificationsRegistrationTestWithContext(context.Background(), getNotificationsRegistrationTestOptions)
+}
+
+// GetNotificationsRegistrationTestWithContext calls GetNotificationsRegistrationTestFunc.
+func (mock *MockSecretsManagerV2) GetNotificationsRegistrationTestWithContext(ctx context.Context, getNotificationsRegistrationTestOptions *secretsmanagerv2.GetNotificationsRegistrationTestOptions) (response *core.DetailedResponse, err error) {
+	mock.calls.record("GetNotificationsRegistrationTest", getNotificationsRegistrationTestOptions)
+	if mock.GetNotificationsRegistrationTestFunc == nil {
+		err = errNotMocked("GetNotificationsRegistrationTest")
+		return
+	}
+	return mock.GetNotificationsRegistrationTestFunc(ctx, getNotificationsRegistrationTestOptions)
+}
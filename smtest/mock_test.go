@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient is a decorator that counts the secrets that are retrieved through it.
+type countingClient struct {
+	secretsmanagerv2.SecretsManagerV2Intf
+	count int
+}
+
+func (client *countingClient) GetSecretWithContext(ctx context.Context, getSecretOptions *secretsmanagerv2.GetSecretOptions) (secretsmanagerv2.SecretIntf, *core.DetailedResponse, error) {
+	client.count++
+	return client.SecretsManagerV2Intf.GetSecretWithContext(ctx, getSecretOptions)
+}
+
+func TestMockSecretsManagerV2(t *testing.T) {
+	mock := &smtest.MockSecretsManagerV2{
+		GetSecretFunc: func(ctx context.Context, getSecretOptions *secretsmanagerv2.GetSecretOptions) (secretsmanagerv2.SecretIntf, *core.DetailedResponse, error) {
+			return &secretsmanagerv2.ArbitrarySecret{ID: getSecretOptions.ID, Payload: core.StringPtr("payload")}, &core.DetailedResponse{StatusCode: 200}, nil
+		},
+	}
+
+	var client secretsmanagerv2.SecretsManagerV2Intf = mock
+	secret, response, err := client.GetSecret(&secretsmanagerv2.GetSecretOptions{ID: core.StringPtr("secret-id")})
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, "payload", *secret.(*secretsmanagerv2.ArbitrarySecret).Payload)
+
+	_, err = client.DeleteSecret(&secretsmanagerv2.DeleteSecretOptions{ID: core.StringPtr("secret-id")})
+	assert.ErrorContains(t, err, "'DeleteSecret' is not mocked")
+
+	calls := mock.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "GetSecret", calls[0].Operation)
+	assert.Equal(t, "secret-id", *mock.CallsTo("DeleteSecret")[0].(*secretsmanagerv2.DeleteSecretOptions).ID)
+}
+
+func TestSecretsManagerV2IntfDecorator(t *testing.T) {
+	_, client := newTestClient(t)
+	secret := createArbitrarySecret(t, client, "my-secret", "payload")
+
+	decorated := &countingClient{SecretsManagerV2Intf: client}
+	_, _, err := decorated.GetSecretWithContext(context.Background(), client.NewGetSecretOptions(*secret.ID))
+	require.Nil(t, err)
+	_, _, err = decorated.GetSecretMetadata(client.NewGetSecretMetadataOptions(*secret.ID))
+	require.Nil(t, err)
+	assert.Equal(t, 1, decorated.count)
+}
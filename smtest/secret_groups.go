@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+// namePattern is the pattern of the names of secret groups, secrets and configurations.
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{1,255}$`)
+
+type secretGroupRecord struct {
+	fields map[string]any
+}
+
+func (server *Server) findSecretGroup(id string) (int, *secretGroupRecord) {
+	for i, group := range server.secretGroups {
+		if stringField(group.fields, "id") == id {
+			return i, group
+		}
+	}
+	return -1, nil
+}
+
+func (server *Server) findSecretGroupByName(name string) *secretGroupRecord {
+	for _, group := range server.secretGroups {
+		if stringField(group.fields, "name") == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func (server *Server) createSecretGroup(req *http.Request) (int, any, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	name := stringField(body, "name")
+	if !namePattern.MatchString(name) {
+		return 0, nil, badRequest("The secret group name '%s' is not valid.", name)
+	}
+	if server.findSecretGroupByName(name) != nil {
+		return 0, nil, conflict("A secret group with the name '%s' already exists.", name)
+	}
+
+	now := timestamp()
+	group := &secretGroupRecord{
+		fields: map[string]any{
+			"id":          newID(),
+			"name":        name,
+			"description": stringField(body, "description"),
+			"created_at":  now,
+			"created_by":  CreatedBy,
+			"updated_at":  now,
+		},
+	}
+	server.secretGroups = append(server.secretGroups, group)
+	return http.StatusCreated, group.fields, nil
+}
+
+func (server *Server) listSecretGroups(req *http.Request) (int, any, error) {
+	groups := make([]map[string]any, 0, len(server.secretGroups))
+	for _, group := range server.secretGroups {
+		groups = append(groups, group.fields)
+	}
+	return http.StatusOK, map[string]any{
+		"secret_groups": groups,
+		"total_count":   len(groups),
+	}, nil
+}
+
+func (server *Server) getSecretGroup(req *http.Request) (int, any, error) {
+	_, group := server.findSecretGroup(req.PathValue("id"))
+	if group == nil {
+		return 0, nil, notFound("The secret group '%s' could not be found.", req.PathValue("id"))
+	}
+	return http.StatusOK, group.fields, nil
+}
+
+func (server *Server) updateSecretGroup(req *http.Request) (int, any, error) {
+	_, group := server.findSecretGroup(req.PathValue("id"))
+	if group == nil {
+		return 0, nil, notFound("The secret group '%s' could not be found.", req.PathValue("id"))
+	}
+	if stringField(group.fields, "id") == DefaultSecretGroupID {
+		return 0, nil, badRequest("The default secret group cannot be updated.")
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	if name, ok := body["name"]; ok {
+		name, _ := name.(string)
+		if !namePattern.MatchString(name) {
+			return 0, nil, badRequest("The secret group name '%s' is not valid.", name)
+		}
+		if other := server.findSecretGroupByName(name); other != nil && other != group {
+			return 0, nil, conflict("A secret group with the name '%s' already exists.", name)
+		}
+	}
+	err = mergePatch(group.fields, body, "id", "created_at", "created_by", "updated_at")
+	if err != nil {
+		return 0, nil, err
+	}
+	group.fields["updated_at"] = timestamp()
+	return http.StatusOK, group.fields, nil
+}
+
+func (server *Server) deleteSecretGroup(req *http.Request) (int, any, error) {
+	id := req.PathValue("id")
+	index, group := server.findSecretGroup(id)
+	if group == nil {
+		return 0, nil, notFound("The secret group '%s' could not be found.", id)
+	}
+	if id == DefaultSecretGroupID {
+		return 0, nil, badRequest("The default secret group cannot be deleted.")
+	}
+	for _, secret := range server.secrets {
+		if stringField(secret.fields, "secret_group_id") == id {
+			return 0, nil, preconditionFailed("The secret group '%s' cannot be deleted because it contains secrets.", id)
+		}
+	}
+	server.secretGroups = slices.Delete(server.secretGroups, index, index+1)
+	return http.StatusNoContent, nil, nil
+}
@@ -0,0 +1,817 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// The values of the "state" field of secrets.
+const (
+	statePreActivation = 0
+	stateActive        = 1
+)
+
+// secretTypeInfo describes the fields of one type of secret.
+type secretTypeInfo struct {
+	// The fields that are only returned together with the payload of the secret.
+	payloadFields []string
+
+	// The fields of the metadata of the secret.
+	metadataFields []string
+
+	// The fields of the metadata of the versions of the secret.
+	versionFields []string
+
+	// The fields that must be specified when the secret is created.
+	requiredFields []string
+}
+
+func newSecretTypeInfo(secret, metadata, versionMetadata any, requiredFields ...string) secretTypeInfo {
+	return secretTypeInfo{
+		payloadFields:  privateFields(secret, metadata),
+		metadataFields: jsonFields(metadata),
+		versionFields:  jsonFields(versionMetadata),
+		requiredFields: requiredFields,
+	}
+}
+
+var secretTypes = map[string]secretTypeInfo{
+	secretsmanagerv2.Secret_SecretType_Arbitrary: newSecretTypeInfo(
+		secretsmanagerv2.ArbitrarySecret{}, secretsmanagerv2.ArbitrarySecretMetadata{}, secretsmanagerv2.ArbitrarySecretVersionMetadata{},
+		"payload"),
+	secretsmanagerv2.Secret_SecretType_CustomCredentials: newSecretTypeInfo(
+		secretsmanagerv2.CustomCredentialsSecret{}, secretsmanagerv2.CustomCredentialsSecretMetadata{}, secretsmanagerv2.CustomCredentialsSecretVersionMetadata{},
+		"configuration"),
+	secretsmanagerv2.Secret_SecretType_IamCredentials: newSecretTypeInfo(
+		secretsmanagerv2.IAMCredentialsSecret{}, secretsmanagerv2.IAMCredentialsSecretMetadata{}, secretsmanagerv2.IAMCredentialsSecretVersionMetadata{},
+		"ttl"),
+	secretsmanagerv2.Secret_SecretType_ImportedCert: newSecretTypeInfo(
+		secretsmanagerv2.ImportedCertificate{}, secretsmanagerv2.ImportedCertificateMetadata{}, secretsmanagerv2.ImportedCertificateVersionMetadata{},
+		"certificate"),
+	secretsmanagerv2.Secret_SecretType_Kv: newSecretTypeInfo(
+		secretsmanagerv2.KVSecret{}, secretsmanagerv2.KVSecretMetadata{}, secretsmanagerv2.KVSecretVersionMetadata{},
+		"data"),
+	secretsmanagerv2.Secret_SecretType_PrivateCert: newSecretTypeInfo(
+		secretsmanagerv2.PrivateCertificate{}, secretsmanagerv2.PrivateCertificateMetadata{}, secretsmanagerv2.PrivateCertificateVersionMetadata{},
+		"certificate_template", "common_name"),
+	secretsmanagerv2.Secret_SecretType_PublicCert: newSecretTypeInfo(
+		secretsmanagerv2.PublicCertificate{}, secretsmanagerv2.PublicCertificateMetadata{}, secretsmanagerv2.PublicCertificateVersionMetadata{},
+		"common_name", "ca", "dns"),
+	secretsmanagerv2.Secret_SecretType_ServiceCredentials: newSecretTypeInfo(
+		secretsmanagerv2.ServiceCredentialsSecret{}, secretsmanagerv2.ServiceCredentialsSecretMetadata{}, secretsmanagerv2.ServiceCredentialsSecretVersionMetadata{},
+		"source_service"),
+	secretsmanagerv2.Secret_SecretType_UsernamePassword: newSecretTypeInfo(
+		secretsmanagerv2.UsernamePasswordSecret{}, secretsmanagerv2.UsernamePasswordSecretMetadata{}, secretsmanagerv2.UsernamePasswordSecretVersionMetadata{},
+		"username"),
+}
+
+// secretReadOnlyFields are the fields of secrets that cannot be updated with a patch.
+var secretReadOnlyFields = []string{
+	"id", "crn", "secret_type", "secret_group_id", "created_by", "created_at", "updated_at",
+	"versions_total", "locks_total", "state", "state_description", "downloaded", "retrieved_at",
+	"next_rotation_date",
+}
+
+type secretRecord struct {
+	// The metadata of the secret, without the computed fields.
+	fields map[string]any
+
+	// The versions of the secret that are retained, from the oldest to the current version.
+	versions []*versionRecord
+
+	tasks []map[string]any
+}
+
+type versionRecord struct {
+	// The metadata of the version, without the computed fields.
+	fields map[string]any
+
+	// The payload fields of the version, or nil if the payload is not available.
+	payload map[string]any
+
+	locks []map[string]any
+}
+
+func (secret *secretRecord) secretType() string {
+	return stringField(secret.fields, "secret_type")
+}
+
+func (secret *secretRecord) current() *versionRecord {
+	return secret.versions[len(secret.versions)-1]
+}
+
+func (secret *secretRecord) previous() *versionRecord {
+	if len(secret.versions) < 2 {
+		return nil
+	}
+	return secret.versions[len(secret.versions)-2]
+}
+
+func (secret *secretRecord) alias(version *versionRecord) string {
+	switch version {
+	case secret.current():
+		return secretsmanagerv2.SecretVersionMetadata_Alias_Current
+	case secret.previous():
+		return secretsmanagerv2.SecretVersionMetadata_Alias_Previous
+	}
+	return ""
+}
+
+func (secret *secretRecord) locksTotal() (total int) {
+	for _, version := range secret.versions {
+		total += len(version.locks)
+	}
+	return
+}
+
+// metadata returns the metadata of the secret, as it is returned by the service.
+func (secret *secretRecord) metadata() map[string]any {
+	result := copyFields(secret.fields)
+	result["locks_total"] = secret.locksTotal()
+	return result
+}
+
+// withPayload returns the secret together with the payload of its current version.
+func (secret *secretRecord) withPayload() map[string]any {
+	result := secret.metadata()
+	for name, value := range secret.current().payload {
+		result[name] = value
+	}
+	return result
+}
+
+// versionMetadata returns the metadata of a version of the secret, as it is returned by the service.
+func (secret *secretRecord) versionMetadata(version *versionRecord) map[string]any {
+	result := copyFields(version.fields)
+	result["secret_id"] = secret.fields["id"]
+	result["secret_name"] = secret.fields["name"]
+	result["secret_type"] = secret.fields["secret_type"]
+	result["secret_group_id"] = secret.fields["secret_group_id"]
+	result["payload_available"] = version.payload != nil
+	if alias := secret.alias(version); alias != "" {
+		result["alias"] = alias
+	}
+	return result
+}
+
+func (server *Server) findSecret(id string) (int, *secretRecord) {
+	for i, secret := range server.secrets {
+		if stringField(secret.fields, "id") == id {
+			return i, secret
+		}
+	}
+	return -1, nil
+}
+
+func (server *Server) lookupSecret(id string) (int, *secretRecord, error) {
+	index, secret := server.findSecret(id)
+	if secret == nil {
+		return 0, nil, notFound("The secret '%s' could not be found.", id)
+	}
+	return index, secret, nil
+}
+
+func (server *Server) findSecretByNameType(secretType, name, groupID string) *secretRecord {
+	for _, secret := range server.secrets {
+		if secret.secretType() == secretType && stringField(secret.fields, "name") == name && stringField(secret.fields, "secret_group_id") == groupID {
+			return secret
+		}
+	}
+	return nil
+}
+
+// lookupVersion finds a version of a secret by its ID or by its alias.
+func (server *Server) lookupVersion(req *http.Request) (*secretRecord, *versionRecord, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("secret_id"))
+	if err != nil {
+		return nil, nil, err
+	}
+	id := req.PathValue("id")
+	for _, version := range secret.versions {
+		if stringField(version.fields, "id") == id || secret.alias(version) == id {
+			return secret, version, nil
+		}
+	}
+	return nil, nil, notFound("The version '%s' of secret '%s' could not be found.", id, req.PathValue("secret_id"))
+}
+
+func (server *Server) createSecret(req *http.Request) (int, any, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	secretType := stringField(body, "secret_type")
+	typeInfo, ok := secretTypes[secretType]
+	if !ok {
+		return 0, nil, badRequest("The secret type '%s' is not valid.", secretType)
+	}
+	for _, field := range append([]string{"name"}, typeInfo.requiredFields...) {
+		if _, ok := body[field]; !ok {
+			return 0, nil, badRequest("The field '%s' is required for secrets of type '%s'.", field, secretType)
+		}
+	}
+	name := stringField(body, "name")
+	if !namePattern.MatchString(name) {
+		return 0, nil, badRequest("The secret name '%s' is not valid.", name)
+	}
+	groupID := stringField(body, "secret_group_id")
+	if groupID == "" {
+		groupID = DefaultSecretGroupID
+	}
+	if _, group := server.findSecretGroup(groupID); group == nil {
+		return 0, nil, notFound("The secret group '%s' could not be found.", groupID)
+	}
+	if server.findSecretByNameType(secretType, name, groupID) != nil {
+		return 0, nil, conflict("A secret of type '%s' with the name '%s' already exists in secret group '%s'.", secretType, name, groupID)
+	}
+	if secretType == secretsmanagerv2.Secret_SecretType_CustomCredentials {
+		if _, config := server.findConfiguration(stringField(body, "configuration")); config == nil {
+			return 0, nil, notFound("The configuration '%s' could not be found.", stringField(body, "configuration"))
+		}
+	}
+
+	now := timestamp()
+	id := newID()
+	secret := &secretRecord{fields: map[string]any{}}
+	versionBody := map[string]any{}
+	for field, value := range body {
+		if slices.Contains(typeInfo.payloadFields, field) || field == "version_custom_metadata" {
+			versionBody[field] = value
+		} else {
+			secret.fields[field] = value
+		}
+	}
+	secret.fields["id"] = id
+	secret.fields["crn"] = fmt.Sprintf("crn:v1:bluemix:public:secrets-manager:us-south:a/%s:%s:secret:%s", AccountID, InstanceID, id)
+	secret.fields["secret_group_id"] = groupID
+	secret.fields["created_by"] = CreatedBy
+	secret.fields["created_at"] = now
+	secret.fields["updated_at"] = now
+	secret.fields["downloaded"] = false
+	secret.fields["versions_total"] = 0
+	setState(secret.fields, stateActive)
+	setNextRotationDate(secret.fields)
+
+	if _, err = server.addVersion(secret, versionBody, secretsmanagerv2.SecretTask_Trigger_SecretCreation); err != nil {
+		return 0, nil, err
+	}
+	server.secrets = append(server.secrets, secret)
+	return http.StatusCreated, secret.withPayload(), nil
+}
+
+// addVersion creates a new current version of a secret from the payload
+// fields in "body", and updates the metadata of the secret accordingly. Only
+// the current and the previous versions are retained, like in the service.
+func (server *Server) addVersion(secret *secretRecord, body map[string]any, trigger string) (*versionRecord, error) {
+	if previous := secret.previous(); previous != nil && len(previous.locks) > 0 {
+		return nil, preconditionFailed("The secret '%s' cannot be rotated because its previous version is locked.", secret.fields["id"])
+	}
+
+	now := timestamp()
+	version := &versionRecord{
+		fields: map[string]any{
+			"id":         newID(),
+			"created_by": CreatedBy,
+			"created_at": now,
+			"downloaded": false,
+		},
+	}
+	if value, ok := body["version_custom_metadata"]; ok {
+		version.fields["version_custom_metadata"] = value
+	}
+	if err := server.generatePayload(secret, version, body, trigger); err != nil {
+		return nil, err
+	}
+	typeInfo := secretTypes[secret.secretType()]
+	for _, field := range typeInfo.versionFields {
+		if _, ok := version.fields[field]; !ok && slices.Contains(typeInfo.metadataFields, field) && secret.fields[field] != nil {
+			version.fields[field] = secret.fields[field]
+		}
+	}
+
+	if len(secret.versions) == 2 {
+		secret.versions = secret.versions[1:]
+	}
+	secret.versions = append(secret.versions, version)
+	versionsTotal, _ := secret.fields["versions_total"].(int)
+	secret.fields["versions_total"] = versionsTotal + 1
+	secret.fields["updated_at"] = now
+	return version, nil
+}
+
+// generatePayload sets the payload of a new version of a secret, either from
+// the fields in "body" or by generating it like the service does.
+func (server *Server) generatePayload(secret *secretRecord, version *versionRecord, body map[string]any, trigger string) error {
+	secretType := secret.secretType()
+	payload := map[string]any{}
+	switch secretType {
+	case secretsmanagerv2.Secret_SecretType_Arbitrary, secretsmanagerv2.Secret_SecretType_Kv:
+		field := secretTypes[secretType].requiredFields[0]
+		if body[field] == nil {
+			return badRequest("The field '%s' is required for secrets of type '%s'.", field, secretType)
+		}
+		payload[field] = body[field]
+
+	case secretsmanagerv2.Secret_SecretType_UsernamePassword:
+		username := body["username"]
+		if username == nil {
+			username = secret.currentPayloadField("username")
+		}
+		password := body["password"]
+		if password == nil {
+			password = randomString(32)
+		}
+		payload["username"] = username
+		payload["password"] = password
+
+	case secretsmanagerv2.Secret_SecretType_IamCredentials:
+		if stringField(secret.fields, "service_id") == "" {
+			secret.fields["service_id"] = "ServiceId-" + newID()
+		}
+		secret.fields["api_key_id"] = "ApiKey-" + newID()
+		version.fields["service_id"] = secret.fields["service_id"]
+		version.fields["api_key_id"] = secret.fields["api_key_id"]
+		payload["api_key"] = randomString(44)
+
+	case secretsmanagerv2.Secret_SecretType_ServiceCredentials:
+		payload["credentials"] = map[string]any{
+			"apikey":            randomString(44),
+			"iam_apikey_id":     "ApiKey-" + newID(),
+			"iam_serviceid_crn": fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::serviceid:ServiceId-%s", AccountID, newID()),
+		}
+
+	case secretsmanagerv2.Secret_SecretType_ImportedCert:
+		certificate, _ := body["certificate"].(string)
+		if certificate == "" {
+			return badRequest("The field 'certificate' is required for secrets of type '%s'.", secretType)
+		}
+		description, err := describeCertificatePEM(certificate)
+		if err != nil {
+			return badRequest("The certificate is not valid: %s", err.Error())
+		}
+		for _, field := range []string{"certificate", "intermediate", "private_key"} {
+			if body[field] != nil {
+				payload[field] = body[field]
+			}
+		}
+		description["intermediate_included"] = body["intermediate"] != nil
+		description["private_key_included"] = body["private_key"] != nil
+		server.setCertificateMetadata(secret, version, description)
+
+	case secretsmanagerv2.Secret_SecretType_PrivateCert:
+		issued, description, err := issueCertificate(secret.fields)
+		if err != nil {
+			return err
+		}
+		payload = issued
+		server.setCertificateMetadata(secret, version, description)
+
+	case secretsmanagerv2.Secret_SecretType_PublicCert, secretsmanagerv2.Secret_SecretType_CustomCredentials:
+		// The payload is provided asynchronously; by a DNS challenge for public
+		// certificates and by a credentials provider for custom credentials.
+		payload = nil
+		if secretType == secretsmanagerv2.Secret_SecretType_CustomCredentials {
+			server.addTask(secret, version, secretsmanagerv2.SecretTask_Type_CreateCredentials, trigger)
+		}
+		if secret.versions == nil {
+			setState(secret.fields, statePreActivation)
+		}
+	}
+	version.payload = payload
+	if expiration := secret.fields["expiration_date"]; expiration != nil {
+		version.fields["expiration_date"] = expiration
+	}
+	return nil
+}
+
+// currentPayloadField returns a field of the payload of the current version of a secret.
+func (secret *secretRecord) currentPayloadField(field string) any {
+	if len(secret.versions) == 0 || secret.current().payload == nil {
+		return nil
+	}
+	return secret.current().payload[field]
+}
+
+// setCertificateMetadata records the description of a certificate on both the secret and the version.
+func (server *Server) setCertificateMetadata(secret *secretRecord, version *versionRecord, description map[string]any) {
+	typeInfo := secretTypes[secret.secretType()]
+	for field, value := range description {
+		if slices.Contains(typeInfo.metadataFields, field) {
+			secret.fields[field] = value
+		}
+		if slices.Contains(typeInfo.versionFields, field) {
+			version.fields[field] = value
+		}
+	}
+}
+
+func (server *Server) listSecrets(req *http.Request) (int, any, error) {
+	groups := queryList(req, "groups")
+	secretTypesFilter := queryList(req, "secret_types")
+	labels := queryList(req, "match_all_labels")
+	search := req.URL.Query().Get("search")
+
+	secrets := []map[string]any{}
+	for _, secret := range server.secrets {
+		if len(groups) > 0 && !slices.Contains(groups, stringField(secret.fields, "secret_group_id")) {
+			continue
+		}
+		if len(secretTypesFilter) > 0 && !slices.Contains(secretTypesFilter, secret.secretType()) {
+			continue
+		}
+		secretLabels := stringList(secret.fields["labels"])
+		if !containsAll(secretLabels, labels) {
+			continue
+		}
+		if search != "" && !strings.Contains(stringField(secret.fields, "name"), search) && stringField(secret.fields, "id") != search &&
+			!slices.ContainsFunc(secretLabels, func(label string) bool { return strings.Contains(label, search) }) {
+			continue
+		}
+		secrets = append(secrets, secret.metadata())
+	}
+	if err := sortByField(secrets, req.URL.Query().Get("sort"), "id", "created_at", "updated_at", "expiration_date", "secret_type", "name"); err != nil {
+		return 0, nil, err
+	}
+	result, err := paginate(req, "secrets", secrets)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, result, nil
+}
+
+func (server *Server) getSecret(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, server.retrieve(secret), nil
+}
+
+// retrieve returns a secret with its payload and records that it was downloaded.
+func (server *Server) retrieve(secret *secretRecord) map[string]any {
+	now := timestamp()
+	secret.fields["downloaded"] = true
+	secret.fields["retrieved_at"] = now
+	secret.current().fields["downloaded"] = true
+	return secret.withPayload()
+}
+
+func (server *Server) deleteSecret(req *http.Request) (int, any, error) {
+	index, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if secret.locksTotal() > 0 {
+		return 0, nil, preconditionFailed("The secret '%s' cannot be deleted because it is locked.", secret.fields["id"])
+	}
+	forceDelete, _ := strconv.ParseBool(req.URL.Query().Get("force_delete"))
+	if forceDelete && secret.secretType() != secretsmanagerv2.Secret_SecretType_CustomCredentials {
+		return 0, nil, badRequest("The 'force_delete' parameter is only supported by secrets of type '%s'.", secretsmanagerv2.Secret_SecretType_CustomCredentials)
+	}
+	if !forceDelete && secret.hasPendingTasks() {
+		return 0, nil, preconditionFailed("The secret '%s' cannot be deleted because it has pending tasks.", secret.fields["id"])
+	}
+	server.secrets = slices.Delete(server.secrets, index, index+1)
+	return http.StatusNoContent, nil, nil
+}
+
+func (server *Server) getSecretMetadata(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, secret.metadata(), nil
+}
+
+func (server *Server) updateSecretMetadata(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	if name, ok := body["name"]; ok {
+		name, _ := name.(string)
+		if !namePattern.MatchString(name) {
+			return 0, nil, badRequest("The secret name '%s' is not valid.", name)
+		}
+		if other := server.findSecretByNameType(secret.secretType(), name, stringField(secret.fields, "secret_group_id")); other != nil && other != secret {
+			return 0, nil, conflict("A secret of type '%s' with the name '%s' already exists in secret group '%s'.", secret.secretType(), name, secret.fields["secret_group_id"])
+		}
+	}
+	readOnly := append(slices.Clone(secretReadOnlyFields), secretTypes[secret.secretType()].payloadFields...)
+	if err = mergePatch(secret.fields, body, readOnly...); err != nil {
+		return 0, nil, err
+	}
+	setNextRotationDate(secret.fields)
+	secret.fields["updated_at"] = timestamp()
+	return http.StatusOK, secret.metadata(), nil
+}
+
+func (server *Server) createSecretAction(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	actionType := stringField(body, "action_type")
+	switch {
+	case actionType == secretsmanagerv2.SecretAction_ActionType_PrivateCertActionRevokeCertificate && secret.secretType() == secretsmanagerv2.Secret_SecretType_PrivateCert:
+		revocationTime := time.Now().UTC()
+		secret.fields["revocation_time_seconds"] = revocationTime.Unix()
+		secret.fields["revocation_time_rfc3339"] = strfmt.DateTime(revocationTime)
+		return http.StatusCreated, map[string]any{
+			"action_type":             actionType,
+			"revocation_time_seconds": revocationTime.Unix(),
+		}, nil
+
+	case actionType == secretsmanagerv2.SecretAction_ActionType_PublicCertActionValidateDnsChallenge && secret.secretType() == secretsmanagerv2.Secret_SecretType_PublicCert:
+		// The certificate is issued as soon as the challenge is validated.
+		version := secret.current()
+		if version.payload == nil {
+			issued, description, err := issueCertificate(secret.fields)
+			if err != nil {
+				return 0, nil, err
+			}
+			delete(issued, "issuing_ca")
+			delete(issued, "ca_chain")
+			issued["intermediate"] = issued["certificate"]
+			version.payload = issued
+			server.setCertificateMetadata(secret, version, description)
+			setState(secret.fields, stateActive)
+			secret.fields["updated_at"] = timestamp()
+		}
+		return http.StatusCreated, map[string]any{"action_type": actionType}, nil
+	}
+	return 0, nil, badRequest("The action '%s' is not supported by secrets of type '%s'.", actionType, secret.secretType())
+}
+
+func (server *Server) getSecretByNameType(req *http.Request) (int, any, error) {
+	groupName := req.PathValue("secret_group_name")
+	group := server.findSecretGroupByName(groupName)
+	if group == nil {
+		return 0, nil, notFound("The secret group '%s' could not be found.", groupName)
+	}
+	secretType, name := req.PathValue("secret_type"), req.PathValue("name")
+	secret := server.findSecretByNameType(secretType, name, stringField(group.fields, "id"))
+	if secret == nil {
+		return 0, nil, notFound("The secret of type '%s' with the name '%s' could not be found in secret group '%s'.", secretType, name, groupName)
+	}
+	return http.StatusOK, server.retrieve(secret), nil
+}
+
+func (server *Server) createSecretVersion(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("secret_id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	version, err := server.addVersion(secret, body, secretsmanagerv2.SecretTask_Trigger_ManualSecretRotation)
+	if err != nil {
+		return 0, nil, err
+	}
+	setNextRotationDate(secret.fields)
+	return http.StatusCreated, secret.versionWithPayload(version), nil
+}
+
+// versionWithPayload returns a version of the secret together with its payload.
+func (secret *secretRecord) versionWithPayload(version *versionRecord) map[string]any {
+	result := secret.versionMetadata(version)
+	for name, value := range version.payload {
+		result[name] = value
+	}
+	return result
+}
+
+func (server *Server) listSecretVersions(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("secret_id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	versions := []map[string]any{}
+	for i := len(secret.versions) - 1; i >= 0; i-- {
+		versions = append(versions, secret.versionMetadata(secret.versions[i]))
+	}
+	return http.StatusOK, map[string]any{
+		"versions":    versions,
+		"total_count": len(versions),
+	}, nil
+}
+
+func (server *Server) getSecretVersion(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	version.fields["downloaded"] = true
+	return http.StatusOK, secret.versionWithPayload(version), nil
+}
+
+func (server *Server) deleteSecretVersionData(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(version.locks) > 0 {
+		return 0, nil, preconditionFailed("The data of version '%s' of secret '%s' cannot be deleted because the version is locked.", version.fields["id"], secret.fields["id"])
+	}
+	version.payload = nil
+	return http.StatusNoContent, nil, nil
+}
+
+func (server *Server) getSecretVersionMetadata(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, secret.versionMetadata(version), nil
+}
+
+func (server *Server) updateSecretVersionMetadata(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	for field := range body {
+		if field != "version_custom_metadata" {
+			return 0, nil, badRequest("The field '%s' cannot be updated.", field)
+		}
+	}
+	if err = mergePatch(version.fields, body); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, secret.versionMetadata(version), nil
+}
+
+func (server *Server) createSecretVersionAction(req *http.Request) (int, any, error) {
+	secret, version, err := server.lookupVersion(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	actionType := stringField(body, "action_type")
+	if actionType != secretsmanagerv2.VersionAction_ActionType_PrivateCertActionRevokeCertificate || secret.secretType() != secretsmanagerv2.Secret_SecretType_PrivateCert {
+		return 0, nil, badRequest("The action '%s' is not supported by versions of secrets of type '%s'.", actionType, secret.secretType())
+	}
+	revocationTime := time.Now().UTC()
+	version.fields["revocation_time_seconds"] = revocationTime.Unix()
+	version.fields["revocation_time_rfc3339"] = strfmt.DateTime(revocationTime)
+	return http.StatusCreated, map[string]any{
+		"action_type":             actionType,
+		"revocation_time_seconds": revocationTime.Unix(),
+	}, nil
+}
+
+func setState(fields map[string]any, state int) {
+	fields["state"] = state
+	switch state {
+	case statePreActivation:
+		fields["state_description"] = secretsmanagerv2.Secret_StateDescription_PreActivation
+	case stateActive:
+		fields["state_description"] = secretsmanagerv2.Secret_StateDescription_Active
+	}
+}
+
+// setNextRotationDate computes the "next_rotation_date" of a secret from its rotation policy.
+func setNextRotationDate(fields map[string]any) {
+	delete(fields, "next_rotation_date")
+	rotation, _ := fields["rotation"].(map[string]any)
+	if autoRotate, _ := rotation["auto_rotate"].(bool); !autoRotate {
+		return
+	}
+	interval, _ := strconv.Atoi(fmt.Sprint(rotation["interval"]))
+	next := time.Now().UTC()
+	switch rotation["unit"] {
+	case secretsmanagerv2.RotationPolicy_Unit_Hour:
+		next = next.Add(time.Duration(interval) * time.Hour)
+	case secretsmanagerv2.RotationPolicy_Unit_Day:
+		next = next.AddDate(0, 0, interval)
+	case secretsmanagerv2.RotationPolicy_Unit_Month:
+		next = next.AddDate(0, interval, 0)
+	default:
+		return
+	}
+	fields["next_rotation_date"] = strfmt.DateTime(next.Truncate(time.Millisecond))
+}
+
+// jsonFields returns the names of the JSON fields of a model.
+func jsonFields(model any) (fields []string) {
+	modelType := reflect.TypeOf(model)
+	for i := 0; i < modelType.NumField(); i++ {
+		name, _, _ := strings.Cut(modelType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+	return
+}
+
+// privateFields returns the fields of "model" that are not included in its "metadata" model.
+func privateFields(model, metadata any) (fields []string) {
+	metadataFields := jsonFields(metadata)
+	for _, field := range jsonFields(model) {
+		if !slices.Contains(metadataFields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return
+}
+
+// sortByField sorts "items" by the field that is named in the "sort" query
+// parameter. A leading "-" sorts in descending order.
+func sortByField(items []map[string]any, sortBy string, allowed ...string) error {
+	if sortBy == "" {
+		return nil
+	}
+	field, descending := strings.CutPrefix(sortBy, "-")
+	if !slices.Contains(allowed, field) {
+		return badRequest("The items cannot be sorted by '%s'.", field)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := sortKey(items[i][field]), sortKey(items[j][field])
+		if descending {
+			return a > b
+		}
+		return a < b
+	})
+	return nil
+}
+
+func sortKey(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// stringList converts a decoded JSON array to a slice of strings.
+func stringList(value any) (result []string) {
+	switch list := value.(type) {
+	case []string:
+		return list
+	case []any:
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+	}
+	return
+}
+
+func containsAll(values []string, required []string) bool {
+	for _, value := range required {
+		if !slices.Contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func randomString(length int) string {
+	b := make([]byte, length)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)[:length]
+}
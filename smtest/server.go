@@ -0,0 +1,391 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package smtest provides test doubles for the Secrets Manager v2 SDK: an
+// in-memory fake of the REST API, and MockSecretsManagerV2, a mock
+// implementation of secretsmanagerv2.SecretsManagerV2Intf.
+//
+// The fake implements secret groups, secrets of every type, secret versions,
+// locks, tasks, configurations and the notifications registration. State is
+// held in memory and follows the behavior of the service closely enough for
+// client code to be tested against it: secrets are versioned on rotation, the
+// "current" and "previous" aliases move accordingly, locked versions cannot
+// be deleted, and errors are returned with the same status codes and payload
+// format as the service.
+//
+//	server := smtest.NewServer()
+//	defer server.Close()
+//
+//	secretsManager, err := server.NewClient()
+package smtest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	// InstanceID is the ID of the Secrets Manager instance that the fake
+	// server impersonates. It is used to build the CRNs of secrets.
+	InstanceID = "00000000-0000-0000-0000-000000000000"
+
+	// AccountID is the ID of the account that owns the fake instance.
+	AccountID = "00000000000000000000000000000000"
+
+	// CreatedBy is the identity that is recorded as the creator of resources.
+	CreatedBy = "iam-ServiceId-00000000-0000-0000-0000-000000000000"
+
+	// DefaultSecretGroupID is the ID of the secret group that always exists.
+	DefaultSecretGroupID = "default"
+)
+
+const (
+	defaultPageLimit = 200
+	maxPageLimit     = 1000
+)
+
+// Server is a fake Secrets Manager instance that is served over HTTP by an
+// httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	mux *http.ServeMux
+
+	mu            sync.Mutex
+	secretGroups  []*secretGroupRecord
+	secrets       []*secretRecord
+	configs       []*configurationRecord
+	notifications map[string]any
+}
+
+// NewServer starts and returns a new fake server. The caller should call
+// Close when finished, to shut it down.
+func NewServer() *Server {
+	server := NewUnstartedServer()
+	server.Start()
+	return server
+}
+
+// NewUnstartedServer returns a new fake server that is not started, so that
+// its configuration can be changed before Start or StartTLS is called.
+func NewUnstartedServer() *Server {
+	server := &Server{
+		mux: http.NewServeMux(),
+	}
+	server.Reset()
+	server.routes()
+	server.Server = httptest.NewUnstartedServer(server)
+	return server
+}
+
+// NewClient returns a SecretsManagerV2 client that sends its requests to the
+// server without authentication.
+func (server *Server) NewClient() (*secretsmanagerv2.SecretsManagerV2, error) {
+	client, err := secretsmanagerv2.NewSecretsManagerV2(&secretsmanagerv2.SecretsManagerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if server.TLS != nil {
+		client.Service.SetHTTPClient(server.Client())
+	}
+	return client, nil
+}
+
+// Reset discards all the state of the server. Only the default secret group
+// remains afterwards.
+func (server *Server) Reset() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	now := timestamp()
+	server.secretGroups = []*secretGroupRecord{{
+		fields: map[string]any{
+			"id":          DefaultSecretGroupID,
+			"name":        DefaultSecretGroupID,
+			"description": "The default secret group.",
+			"created_at":  now,
+			"created_by":  CreatedBy,
+			"updated_at":  now,
+		},
+	}}
+	server.secrets = nil
+	server.configs = nil
+	server.notifications = nil
+}
+
+// ServeHTTP dispatches a request to the handler of its operation.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	if _, pattern := server.mux.Handler(req); pattern == "" {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("The requested resource '%s %s' could not be found.", req.Method, req.URL.Path))
+		return
+	}
+	server.mux.ServeHTTP(res, req)
+}
+
+// handle registers an operation. The handler runs with the lock of the server held.
+func (server *Server) handle(pattern string, handler func(*http.Request) (int, any, error)) {
+	server.mux.HandleFunc(pattern, func(res http.ResponseWriter, req *http.Request) {
+		server.mu.Lock()
+		status, body, err := handler(req)
+		server.mu.Unlock()
+
+		if err != nil {
+			if apiErr, ok := err.(*apiError); ok {
+				writeError(res, apiErr.status, apiErr.code, apiErr.message)
+			} else {
+				writeError(res, http.StatusInternalServerError, "internal_server_error", err.Error())
+			}
+			return
+		}
+		if body == nil {
+			res.WriteHeader(status)
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(status)
+		_ = json.NewEncoder(res).Encode(body)
+	})
+}
+
+func (server *Server) routes() {
+	server.handle("POST /api/v2/secret_groups", server.createSecretGroup)
+	server.handle("GET /api/v2/secret_groups", server.listSecretGroups)
+	server.handle("GET /api/v2/secret_groups/{id}", server.getSecretGroup)
+	server.handle("PATCH /api/v2/secret_groups/{id}", server.updateSecretGroup)
+	server.handle("DELETE /api/v2/secret_groups/{id}", server.deleteSecretGroup)
+
+	server.handle("POST /api/v2/secrets", server.createSecret)
+	server.handle("GET /api/v2/secrets", server.listSecrets)
+	server.handle("GET /api/v2/secrets/{id}", server.getSecret)
+	server.handle("DELETE /api/v2/secrets/{id}", server.deleteSecret)
+	server.handle("GET /api/v2/secrets/{id}/metadata", server.getSecretMetadata)
+	server.handle("PATCH /api/v2/secrets/{id}/metadata", server.updateSecretMetadata)
+	server.handle("POST /api/v2/secrets/{id}/actions", server.createSecretAction)
+	server.handle("GET /api/v2/secret_groups/{secret_group_name}/secret_types/{secret_type}/secrets/{name}", server.getSecretByNameType)
+
+	server.handle("POST /api/v2/secrets/{secret_id}/versions", server.createSecretVersion)
+	server.handle("GET /api/v2/secrets/{secret_id}/versions", server.listSecretVersions)
+	server.handle("GET /api/v2/secrets/{secret_id}/versions/{id}", server.getSecretVersion)
+	server.handle("DELETE /api/v2/secrets/{secret_id}/versions/{id}/secret_data", server.deleteSecretVersionData)
+	server.handle("GET /api/v2/secrets/{secret_id}/versions/{id}/metadata", server.getSecretVersionMetadata)
+	server.handle("PATCH /api/v2/secrets/{secret_id}/versions/{id}/metadata", server.updateSecretVersionMetadata)
+	server.handle("POST /api/v2/secrets/{secret_id}/versions/{id}/actions", server.createSecretVersionAction)
+
+	server.handle("GET /api/v2/secrets/{secret_id}/tasks", server.listSecretTasks)
+	server.handle("GET /api/v2/secrets/{secret_id}/tasks/{id}", server.getSecretTask)
+	server.handle("PUT /api/v2/secrets/{secret_id}/tasks/{id}", server.replaceSecretTask)
+	server.handle("DELETE /api/v2/secrets/{secret_id}/tasks/{id}", server.deleteSecretTask)
+
+	server.handle("GET /api/v2/secrets_locks", server.listSecretsLocks)
+	server.handle("GET /api/v2/secrets/{id}/locks", server.listSecretLocks)
+	server.handle("POST /api/v2/secrets/{id}/locks_bulk", server.createSecretLocksBulk)
+	server.handle("DELETE /api/v2/secrets/{id}/locks_bulk", server.deleteSecretLocksBulk)
+	server.handle("GET /api/v2/secrets/{secret_id}/versions/{id}/locks", server.listSecretVersionLocks)
+	server.handle("POST /api/v2/secrets/{secret_id}/versions/{id}/locks_bulk", server.createSecretVersionLocksBulk)
+	server.handle("DELETE /api/v2/secrets/{secret_id}/versions/{id}/locks_bulk", server.deleteSecretVersionLocksBulk)
+
+	server.handle("POST /api/v2/configurations", server.createConfiguration)
+	server.handle("GET /api/v2/configurations", server.listConfigurations)
+	server.handle("GET /api/v2/configurations/{name}", server.getConfiguration)
+	server.handle("PATCH /api/v2/configurations/{name}", server.updateConfiguration)
+	server.handle("DELETE /api/v2/configurations/{name}", server.deleteConfiguration)
+	server.handle("POST /api/v2/configurations/{name}/actions", server.createConfigurationAction)
+
+	server.handle("POST /api/v2/notifications/registration", server.createNotificationsRegistration)
+	server.handle("GET /api/v2/notifications/registration", server.getNotificationsRegistration)
+	server.handle("DELETE /api/v2/notifications/registration", server.deleteNotificationsRegistration)
+	server.handle("GET /api/v2/notifications/registration/test", server.testNotificationsRegistration)
+}
+
+// apiError is an error that is returned to the client with the payload format of the service.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...any) error {
+	return &apiError{http.StatusBadRequest, "bad_request", fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) error {
+	return &apiError{http.StatusNotFound, "not_found", fmt.Sprintf(format, args...)}
+}
+
+func conflict(format string, args ...any) error {
+	return &apiError{http.StatusConflict, "conflict", fmt.Sprintf(format, args...)}
+}
+
+func preconditionFailed(format string, args ...any) error {
+	return &apiError{http.StatusPreconditionFailed, "precondition_failed", fmt.Sprintf(format, args...)}
+}
+
+func writeError(res http.ResponseWriter, status int, code string, message string) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	_ = json.NewEncoder(res).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"code":    code,
+			"message": message,
+		}},
+		"status_code": status,
+		"trace":       newID(),
+	})
+}
+
+// readBody decodes the JSON object in the body of a request.
+func readBody(req *http.Request) (body map[string]any, err error) {
+	decoder := json.NewDecoder(req.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&body); err != nil || body == nil {
+		return nil, badRequest("The request body is not a valid JSON object.")
+	}
+	return
+}
+
+// mergePatch applies a JSON merge patch to "target", rejecting changes to
+// the fields in "readOnly".
+func mergePatch(target map[string]any, patch map[string]any, readOnly ...string) error {
+	for _, field := range readOnly {
+		if _, ok := patch[field]; ok {
+			return badRequest("The field '%s' cannot be updated.", field)
+		}
+	}
+	for field, value := range patch {
+		if value == nil {
+			delete(target, field)
+		} else {
+			target[field] = value
+		}
+	}
+	return nil
+}
+
+// paginate returns the page of "items" that is selected by the "offset" and
+// "limit" query parameters, in the form of a paginated collection.
+func paginate[T any](req *http.Request, collection string, items []T) (map[string]any, error) {
+	query := req.URL.Query()
+	offset, limit := 0, defaultPageLimit
+	if value := query.Get("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, badRequest("The value of 'offset' must be a non-negative integer.")
+		}
+		offset = parsed
+	}
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			return nil, badRequest("The value of 'limit' must be an integer between 1 and %d.", maxPageLimit)
+		}
+		limit = parsed
+	}
+
+	page := []T{}
+	if offset < len(items) {
+		page = items[offset:min(offset+limit, len(items))]
+	}
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	href := func(offset int) map[string]any {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("offset", strconv.Itoa(offset))
+		pageQuery.Set("limit", strconv.Itoa(limit))
+		return map[string]any{"href": scheme + "://" + req.Host + req.URL.Path + "?" + pageQuery.Encode()}
+	}
+	lastOffset := 0
+	if len(items) > 0 {
+		lastOffset = (len(items) - 1) / limit * limit
+	}
+	result := map[string]any{
+		"total_count": len(items),
+		"limit":       limit,
+		"offset":      offset,
+		"first":       href(0),
+		"last":        href(lastOffset),
+		collection:    page,
+	}
+	if offset+limit < len(items) {
+		result["next"] = href(offset + limit)
+	}
+	if offset > 0 {
+		result["previous"] = href(max(offset-limit, 0))
+	}
+	return result, nil
+}
+
+// queryList returns the values of a comma-separated query parameter.
+func queryList(req *http.Request, name string) (values []string) {
+	for _, value := range strings.Split(req.URL.Query().Get(name), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return
+}
+
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func timestamp() strfmt.DateTime {
+	return strfmt.DateTime(time.Now().UTC().Truncate(time.Millisecond))
+}
+
+func stringField(fields map[string]any, name string) string {
+	value, _ := fields[name].(string)
+	return value
+}
+
+// copyFields returns a shallow copy of "fields" without the fields in "omit".
+func copyFields(fields map[string]any, omit ...string) map[string]any {
+	result := make(map[string]any, len(fields))
+	for name, value := range fields {
+		result[name] = value
+	}
+	for _, name := range omit {
+		delete(result, name)
+	}
+	return result
+}
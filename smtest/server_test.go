@@ -0,0 +1,307 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/smtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) (*smtest.Server, *secretsmanagerv2.SecretsManagerV2) {
+	server := smtest.NewServer()
+	t.Cleanup(server.Close)
+	client, err := server.NewClient()
+	require.Nil(t, err)
+	return server, client
+}
+
+func createArbitrarySecret(t *testing.T, client *secretsmanagerv2.SecretsManagerV2, name string, payload string) *secretsmanagerv2.ArbitrarySecret {
+	prototype, err := client.NewArbitrarySecretPrototype(name, secretsmanagerv2.Secret_SecretType_Arbitrary, payload)
+	require.Nil(t, err)
+	secret, response, err := client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+	assert.Equal(t, 201, response.StatusCode)
+	return secret.(*secretsmanagerv2.ArbitrarySecret)
+}
+
+func TestSecretGroups(t *testing.T) {
+	_, client := newTestClient(t)
+
+	group, response, err := client.CreateSecretGroup(client.NewCreateSecretGroupOptions("my-group"))
+	require.Nil(t, err)
+	assert.Equal(t, 201, response.StatusCode)
+
+	_, response, err = client.CreateSecretGroup(client.NewCreateSecretGroupOptions("my-group"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, response.StatusCode)
+
+	groups, _, err := client.ListSecretGroups(client.NewListSecretGroupsOptions())
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), *groups.TotalCount)
+
+	prototype, err := client.NewArbitrarySecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_Arbitrary, "payload")
+	require.Nil(t, err)
+	prototype.SecretGroupID = group.ID
+	secret, _, err := client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+
+	response, err = client.DeleteSecretGroup(client.NewDeleteSecretGroupOptions(*group.ID))
+	assert.NotNil(t, err)
+	assert.Equal(t, 412, response.StatusCode)
+
+	_, err = client.DeleteSecret(client.NewDeleteSecretOptions(*secret.(*secretsmanagerv2.ArbitrarySecret).ID))
+	require.Nil(t, err)
+	response, err = client.DeleteSecretGroup(client.NewDeleteSecretGroupOptions(*group.ID))
+	require.Nil(t, err)
+	assert.Equal(t, 204, response.StatusCode)
+
+	response, err = client.DeleteSecretGroup(client.NewDeleteSecretGroupOptions(smtest.DefaultSecretGroupID))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestSecretsAndVersions(t *testing.T) {
+	_, client := newTestClient(t)
+	secret := createArbitrarySecret(t, client, "my-secret", "first")
+	assert.Equal(t, "first", *secret.Payload)
+	assert.Equal(t, int64(1), *secret.VersionsTotal)
+	assert.Equal(t, secretsmanagerv2.Secret_StateDescription_Active, *secret.StateDescription)
+
+	metadata, _, err := client.GetSecretMetadata(client.NewGetSecretMetadataOptions(*secret.ID))
+	require.Nil(t, err)
+	assert.False(t, *metadata.(*secretsmanagerv2.ArbitrarySecretMetadata).Downloaded)
+
+	byName, _, err := client.GetSecretByNameType(client.NewGetSecretByNameTypeOptions(secretsmanagerv2.Secret_SecretType_Arbitrary, "my-secret", "default"))
+	require.Nil(t, err)
+	assert.Equal(t, *secret.ID, *byName.(*secretsmanagerv2.ArbitrarySecret).ID)
+	assert.True(t, *byName.(*secretsmanagerv2.ArbitrarySecret).Downloaded)
+
+	versionPrototype, err := client.NewArbitrarySecretVersionPrototype("second")
+	require.Nil(t, err)
+	version, _, err := client.CreateSecretVersion(client.NewCreateSecretVersionOptions(*secret.ID, versionPrototype))
+	require.Nil(t, err)
+	assert.Equal(t, "second", *version.(*secretsmanagerv2.ArbitrarySecretVersion).Payload)
+	assert.Equal(t, secretsmanagerv2.SecretVersionMetadata_Alias_Current, *version.(*secretsmanagerv2.ArbitrarySecretVersion).Alias)
+
+	versions, _, err := client.ListSecretVersions(client.NewListSecretVersionsOptions(*secret.ID))
+	require.Nil(t, err)
+	require.Len(t, versions.Versions, 2)
+	assert.Equal(t, secretsmanagerv2.SecretVersionMetadata_Alias_Previous, *versions.Versions[1].(*secretsmanagerv2.ArbitrarySecretVersionMetadata).Alias)
+
+	previous, _, err := client.GetSecretVersion(client.NewGetSecretVersionOptions(*secret.ID, "previous"))
+	require.Nil(t, err)
+	assert.Equal(t, "first", *previous.(*secretsmanagerv2.ArbitrarySecretVersion).Payload)
+
+	_, err = client.DeleteSecretVersionData(client.NewDeleteSecretVersionDataOptions(*secret.ID, "previous"))
+	require.Nil(t, err)
+	previousMetadata, _, err := client.GetSecretVersionMetadata(client.NewGetSecretVersionMetadataOptions(*secret.ID, "previous"))
+	require.Nil(t, err)
+	assert.False(t, *previousMetadata.(*secretsmanagerv2.ArbitrarySecretVersionMetadata).PayloadAvailable)
+
+	patch, err := (&secretsmanagerv2.ArbitrarySecretMetadataPatch{Labels: []string{"env:test"}}).AsPatch()
+	require.Nil(t, err)
+	updated, _, err := client.UpdateSecretMetadata(client.NewUpdateSecretMetadataOptions(*secret.ID, patch))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"env:test"}, updated.(*secretsmanagerv2.ArbitrarySecretMetadata).Labels)
+	assert.Equal(t, int64(2), *updated.(*secretsmanagerv2.ArbitrarySecretMetadata).VersionsTotal)
+
+	_, response, err := client.UpdateSecretMetadata(client.NewUpdateSecretMetadataOptions(*secret.ID, map[string]interface{}{"payload": "changed"}))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestListSecrets(t *testing.T) {
+	_, client := newTestClient(t)
+	for i := 0; i < 5; i++ {
+		createArbitrarySecret(t, client, fmt.Sprintf("secret-%d", i), "payload")
+	}
+
+	pager, err := client.NewSecretsPager(&secretsmanagerv2.ListSecretsOptions{
+		Limit: core.Int64Ptr(2),
+		Sort:  core.StringPtr("-name"),
+	})
+	require.Nil(t, err)
+	secrets, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, secrets, 5)
+	assert.Equal(t, "secret-4", *secrets[0].(*secretsmanagerv2.ArbitrarySecretMetadata).Name)
+
+	result, _, err := client.ListSecrets(&secretsmanagerv2.ListSecretsOptions{Search: core.StringPtr("secret-3")})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *result.TotalCount)
+
+	result, _, err = client.ListSecrets(&secretsmanagerv2.ListSecretsOptions{SecretTypes: []string{"kv"}})
+	require.Nil(t, err)
+	assert.Empty(t, result.Secrets)
+
+	_, response, err := client.ListSecrets(&secretsmanagerv2.ListSecretsOptions{Sort: core.StringPtr("payload")})
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestLocks(t *testing.T) {
+	_, client := newTestClient(t)
+	secret := createArbitrarySecret(t, client, "my-secret", "first")
+
+	lock, err := client.NewSecretLockPrototype("lock-a")
+	require.Nil(t, err)
+	locks, _, err := client.CreateSecretLocksBulk(client.NewCreateSecretLocksBulkOptions(*secret.ID, []secretsmanagerv2.SecretLockPrototype{*lock}))
+	require.Nil(t, err)
+	require.Len(t, locks.Versions, 1)
+	assert.Equal(t, []string{"lock-a"}, locks.Versions[0].Locks)
+
+	// A locked secret cannot be deleted.
+	response, err := client.DeleteSecret(client.NewDeleteSecretOptions(*secret.ID))
+	assert.NotNil(t, err)
+	assert.Equal(t, 412, response.StatusCode)
+
+	// The locked version becomes the previous version, and blocks the next rotation.
+	versionPrototype, err := client.NewArbitrarySecretVersionPrototype("second")
+	require.Nil(t, err)
+	_, _, err = client.CreateSecretVersion(client.NewCreateSecretVersionOptions(*secret.ID, versionPrototype))
+	require.Nil(t, err)
+	_, response, err = client.CreateSecretVersion(client.NewCreateSecretVersionOptions(*secret.ID, versionPrototype))
+	assert.NotNil(t, err)
+	assert.Equal(t, 412, response.StatusCode)
+
+	secretLocks, _, err := client.ListSecretLocks(client.NewListSecretLocksOptions(*secret.ID))
+	require.Nil(t, err)
+	require.Len(t, secretLocks.Locks, 1)
+	assert.Equal(t, "previous", *secretLocks.Locks[0].SecretVersionAlias)
+
+	// Moving the lock to the current version releases the previous version.
+	createOptions := client.NewCreateSecretLocksBulkOptions(*secret.ID, []secretsmanagerv2.SecretLockPrototype{*lock})
+	createOptions.SetMode(secretsmanagerv2.CreateSecretLocksBulkOptions_Mode_RemovePrevious)
+	_, _, err = client.CreateSecretLocksBulk(createOptions)
+	require.Nil(t, err)
+	_, _, err = client.CreateSecretVersion(client.NewCreateSecretVersionOptions(*secret.ID, versionPrototype))
+	require.Nil(t, err)
+
+	allLocks, _, err := client.ListSecretsLocks(client.NewListSecretsLocksOptions())
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *allLocks.TotalCount)
+
+	_, _, err = client.DeleteSecretLocksBulk(client.NewDeleteSecretLocksBulkOptions(*secret.ID))
+	require.Nil(t, err)
+	_, err = client.DeleteSecret(client.NewDeleteSecretOptions(*secret.ID))
+	require.Nil(t, err)
+}
+
+func TestCustomCredentialsTasks(t *testing.T) {
+	_, client := newTestClient(t)
+
+	codeEngine, err := client.NewCustomCredentialsConfigurationCodeEngine("my-job", "my-project", "us-south")
+	require.Nil(t, err)
+	configPrototype, err := client.NewCustomCredentialsConfigurationPrototype("my-config", secretsmanagerv2.Configuration_ConfigType_CustomCredentialsConfiguration, codeEngine)
+	require.Nil(t, err)
+	_, _, err = client.CreateConfiguration(client.NewCreateConfigurationOptions(configPrototype))
+	require.Nil(t, err)
+
+	prototype, err := client.NewCustomCredentialsSecretPrototype("my-secret", secretsmanagerv2.Secret_SecretType_CustomCredentials, "my-config")
+	require.Nil(t, err)
+	created, _, err := client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+	secret := created.(*secretsmanagerv2.CustomCredentialsSecret)
+	assert.Equal(t, secretsmanagerv2.Secret_StateDescription_PreActivation, *secret.StateDescription)
+
+	tasks, _, err := client.ListSecretTasks(client.NewListSecretTasksOptions(*secret.ID))
+	require.Nil(t, err)
+	require.Len(t, tasks.Tasks, 1)
+	assert.Equal(t, secretsmanagerv2.SecretTask_Status_Queued, *tasks.Tasks[0].Status)
+
+	credentials, err := client.NewCustomCredentialsNewCredentials("credentials-1", map[string]interface{}{"token": "secret-token"})
+	require.Nil(t, err)
+	taskPut, err := client.NewSecretTaskPrototypeUpdateSecretTaskCredentialsCreated(secretsmanagerv2.SecretTask_Status_CredentialsCreated, credentials)
+	require.Nil(t, err)
+	task, _, err := client.ReplaceSecretTask(client.NewReplaceSecretTaskOptions(*secret.ID, *tasks.Tasks[0].ID, taskPut))
+	require.Nil(t, err)
+	assert.Equal(t, secretsmanagerv2.SecretTask_Status_CredentialsCreated, *task.Status)
+
+	retrieved, _, err := client.GetSecret(client.NewGetSecretOptions(*secret.ID))
+	require.Nil(t, err)
+	secret = retrieved.(*secretsmanagerv2.CustomCredentialsSecret)
+	assert.Equal(t, secretsmanagerv2.Secret_StateDescription_Active, *secret.StateDescription)
+	assert.Equal(t, "secret-token", secret.CredentialsContent["token"])
+
+	response, err := client.DeleteConfiguration(client.NewDeleteConfigurationOptions("my-config"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 412, response.StatusCode)
+}
+
+func TestPrivateCertificate(t *testing.T) {
+	_, client := newTestClient(t)
+	prototype, err := client.NewPrivateCertificatePrototype(secretsmanagerv2.Secret_SecretType_PrivateCert, "my-cert", "my-template", "example.com")
+	require.Nil(t, err)
+	prototype.AltNames = []string{"www.example.com"}
+	created, _, err := client.CreateSecret(client.NewCreateSecretOptions(prototype))
+	require.Nil(t, err)
+	secret := created.(*secretsmanagerv2.PrivateCertificate)
+
+	block, _ := pem.Decode([]byte(*secret.Certificate))
+	require.NotNil(t, block)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.Nil(t, err)
+	assert.Equal(t, "example.com", certificate.Subject.CommonName)
+	assert.Contains(t, certificate.DNSNames, "www.example.com")
+	assert.NotNil(t, secret.ExpirationDate)
+	assert.NotEmpty(t, *secret.SerialNumber)
+}
+
+func TestConfigurationsAndNotifications(t *testing.T) {
+	_, client := newTestClient(t)
+	prototype, err := client.NewIAMCredentialsConfigurationPrototype("my-iam-config", secretsmanagerv2.Configuration_ConfigType_IamCredentialsConfiguration, "my-api-key")
+	require.Nil(t, err)
+	_, _, err = client.CreateConfiguration(client.NewCreateConfigurationOptions(prototype))
+	require.Nil(t, err)
+
+	configurations, _, err := client.ListConfigurations(client.NewListConfigurationsOptions())
+	require.Nil(t, err)
+	require.Len(t, configurations.Configurations, 1)
+	config, _, err := client.GetConfiguration(client.NewGetConfigurationOptions("my-iam-config"))
+	require.Nil(t, err)
+	assert.Equal(t, "my-api-key", *config.(*secretsmanagerv2.IAMCredentialsConfiguration).ApiKey)
+
+	_, response, err := client.GetNotificationsRegistration(client.NewGetNotificationsRegistrationOptions())
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+	_, _, err = client.CreateNotificationsRegistration(client.NewCreateNotificationsRegistrationOptions("crn:v1:bluemix:public:event-notifications:us-south:a/123::", "my-source"))
+	require.Nil(t, err)
+	_, err = client.GetNotificationsRegistrationTest(client.NewGetNotificationsRegistrationTestOptions())
+	require.Nil(t, err)
+}
+
+func TestErrorPayload(t *testing.T) {
+	server, client := newTestClient(t)
+	_, response, err := client.GetSecret(client.NewGetSecretOptions("b49ad24d-81d4-5ebc-b9b9-b0937d1c84d5"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+	assert.Contains(t, err.Error(), "could not be found")
+
+	createArbitrarySecret(t, client, "my-secret", "payload")
+	server.Reset()
+	result, _, err := client.ListSecrets(client.NewListSecretsOptions())
+	require.Nil(t, err)
+	assert.Empty(t, result.Secrets)
+}
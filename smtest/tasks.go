@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smtest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// addTask queues a task for the credentials provider of a custom credentials secret.
+func (server *Server) addTask(secret *secretRecord, version *versionRecord, taskType string, trigger string) {
+	now := timestamp()
+	secret.tasks = append(secret.tasks, map[string]any{
+		"id":                newID(),
+		"created_by":        CreatedBy,
+		"creation_date":     now,
+		"last_update_date":  now,
+		"updated_by":        CreatedBy,
+		"type":              taskType,
+		"status":            secretsmanagerv2.SecretTask_Status_Queued,
+		"trigger":           trigger,
+		"secret_id":         secret.fields["id"],
+		"secret_version_id": version.fields["id"],
+	})
+}
+
+func (secret *secretRecord) hasPendingTasks() bool {
+	return slices.ContainsFunc(secret.tasks, func(task map[string]any) bool {
+		status := stringField(task, "status")
+		return status == secretsmanagerv2.SecretTask_Status_Queued || status == secretsmanagerv2.SecretTask_Status_Processing
+	})
+}
+
+func (server *Server) lookupTask(req *http.Request) (*secretRecord, int, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("secret_id"))
+	if err != nil {
+		return nil, 0, err
+	}
+	id := req.PathValue("id")
+	index := slices.IndexFunc(secret.tasks, func(task map[string]any) bool { return stringField(task, "id") == id })
+	if index < 0 {
+		return nil, 0, notFound("The task '%s' of secret '%s' could not be found.", id, req.PathValue("secret_id"))
+	}
+	return secret, index, nil
+}
+
+func (server *Server) listSecretTasks(req *http.Request) (int, any, error) {
+	_, secret, err := server.lookupSecret(req.PathValue("secret_id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	tasks := make([]map[string]any, 0, len(secret.tasks))
+	tasks = append(tasks, secret.tasks...)
+	return http.StatusOK, map[string]any{
+		"tasks":       tasks,
+		"total_count": len(tasks),
+	}, nil
+}
+
+func (server *Server) getSecretTask(req *http.Request) (int, any, error) {
+	secret, index, err := server.lookupTask(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, secret.tasks[index], nil
+}
+
+// replaceSecretTask records the result of a task that was processed by the
+// credentials provider. When credentials are created they become the payload
+// of the version that the task belongs to, and the secret is activated.
+func (server *Server) replaceSecretTask(req *http.Request) (int, any, error) {
+	secret, index, err := server.lookupTask(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := readBody(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	task := secret.tasks[index]
+	status := stringField(body, "status")
+	switch status {
+	case secretsmanagerv2.SecretTask_Status_Processing, secretsmanagerv2.SecretTask_Status_CredentialsDeleted:
+	case secretsmanagerv2.SecretTask_Status_Failed:
+		if body["errors"] == nil {
+			return 0, nil, badRequest("The field 'errors' is required when the status is '%s'.", status)
+		}
+		task["errors"] = body["errors"]
+	case secretsmanagerv2.SecretTask_Status_CredentialsCreated:
+		credentials, _ := body["credentials"].(map[string]any)
+		if credentials == nil || credentials["id"] == nil || credentials["payload"] == nil {
+			return 0, nil, badRequest("The field 'credentials' with an 'id' and a 'payload' is required when the status is '%s'.", status)
+		}
+		versionIndex := slices.IndexFunc(secret.versions, func(version *versionRecord) bool {
+			return version.fields["id"] == task["secret_version_id"]
+		})
+		if versionIndex < 0 {
+			return 0, nil, preconditionFailed("The version of the task '%s' is no longer retained.", task["id"])
+		}
+		secret.versions[versionIndex].payload = map[string]any{"credentials_content": credentials["payload"]}
+		secret.versions[versionIndex].fields["credentials_id"] = credentials["id"]
+		setState(secret.fields, stateActive)
+		secret.fields["updated_at"] = timestamp()
+	default:
+		return 0, nil, badRequest("The task status '%s' is not valid.", status)
+	}
+	task["status"] = status
+	task["last_update_date"] = timestamp()
+	return http.StatusOK, task, nil
+}
+
+func (server *Server) deleteSecretTask(req *http.Request) (int, any, error) {
+	secret, index, err := server.lookupTask(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	secret.tasks = slices.Delete(secret.tasks, index, index+1)
+	return http.StatusNoContent, nil, nil
+}